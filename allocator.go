@@ -0,0 +1,150 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrorNoFreeNode = errors.New("no free node")
+	ErrorNodeNotLeased = errors.New("node is not leased")
+)
+
+/**
+	Allocator of 48-bit nodes unique among the generators of the cluster
+
+	Acquire leases a free node, the lease expires unless renewed by Renew before the time to live
+	of the implementation passes, Release returns the node to the pool; generators must stop issuing
+	UUIDs with the node when Renew fails
+
+	Implementations backed by etcd or consul map the node to a key attached to the session lease,
+	see README for the etcd example
+ */
+
+type NodeAllocator interface {
+
+	/**
+		Leases a free node
+	 */
+
+	Acquire(ctx context.Context) (int64, error)
+
+	/**
+		Extends lease of the node, returns ErrorNodeNotLeased if the lease has expired
+	 */
+
+	Renew(ctx context.Context, node int64) error
+
+	/**
+		Releases lease of the node, returns ErrorNodeNotLeased if the node is not leased
+	 */
+
+	Release(ctx context.Context, node int64) error
+}
+
+/**
+	In-memory NodeAllocator for the nodes of the single process and for tests
+
+	Nodes are issued in order with the multicast bit set,
+	so they never collide with real MAC addresses; expired nodes are issued again
+ */
+
+type MemoryNodeAllocator struct {
+	mutex  sync.Mutex
+	clock  func() time.Time
+	ttl    time.Duration
+	next   int64
+	leases map[int64]time.Time
+}
+
+/**
+	Creates in-memory allocator with the time to live of leases
+ */
+
+func NewMemoryNodeAllocator(ttl time.Duration) *MemoryNodeAllocator {
+	return &MemoryNodeAllocator{
+		clock:  time.Now,
+		ttl:    ttl,
+		leases: make(map[int64]time.Time),
+	}
+}
+
+func (this *MemoryNodeAllocator) Acquire(ctx context.Context) (int64, error) {
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	now := this.clock()
+
+	// one of len(leases) + 1 consecutive nodes is free
+	for i := 0; i <= len(this.leases) && i <= int(multicastNodeBit - 1); i++ {
+		node := this.next | multicastNodeBit
+		this.next = (this.next + 1) & (multicastNodeBit - 1)
+		if expires, ok := this.leases[node]; !ok || !now.Before(expires) {
+			this.leases[node] = now.Add(this.ttl)
+			return node, nil
+		}
+	}
+
+	return 0, ErrorNoFreeNode
+}
+
+func (this *MemoryNodeAllocator) Renew(ctx context.Context, node int64) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	now := this.clock()
+	if expires, ok := this.leases[node]; !ok || !now.Before(expires) {
+		delete(this.leases, node)
+		return errors.Wrapf(ErrorNodeNotLeased, "%#x", node)
+	}
+
+	this.leases[node] = now.Add(this.ttl)
+	return nil
+}
+
+func (this *MemoryNodeAllocator) Release(ctx context.Context, node int64) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if _, ok := this.leases[node]; !ok {
+		return errors.Wrapf(ErrorNodeNotLeased, "%#x", node)
+	}
+
+	delete(this.leases, node)
+	return nil
+}
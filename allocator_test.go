@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryNodeAllocator(t *testing.T) {
+
+	ctx := context.Background()
+	now := time.Now()
+
+	var allocator NodeAllocator = NewMemoryNodeAllocator(time.Minute)
+	allocator.(*MemoryNodeAllocator).clock = func() time.Time { return now }
+
+	first, err := allocator.Acquire(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0x010000000000), first)
+
+	second, err := allocator.Acquire(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0x010000000001), second)
+
+	// renewed lease outlives the expired one
+
+	now = now.Add(30 * time.Second)
+	assert.NoError(t, allocator.Renew(ctx, second))
+
+	now = now.Add(30 * time.Second)
+	err = allocator.Renew(ctx, first)
+	assert.True(t, errors.Is(err, ErrorNodeNotLeased))
+	assert.NoError(t, allocator.Renew(ctx, second))
+
+	// released and expired nodes are issued again
+
+	assert.NoError(t, allocator.Release(ctx, second))
+	err = allocator.Release(ctx, second)
+	assert.True(t, errors.Is(err, ErrorNodeNotLeased))
+
+	third, err := allocator.Acquire(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0x010000000002), third)
+
+	seen := map[int64]bool{ third: true }
+	for i := 0; i != 10; i = i + 1 {
+		node, err := allocator.Acquire(ctx)
+		assert.NoError(t, err)
+		assert.False(t, seen[node])
+		seen[node] = true
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = allocator.Acquire(cancelled)
+	assert.Equal(t, context.Canceled, err)
+
+}
@@ -0,0 +1,57 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+//go:build go1.24
+
+package timeuuid
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryAppender = UUID{}
+	_ encoding.TextAppender = UUID{}
+)
+
+func TestAppender(t *testing.T) {
+
+	uuid, _ := Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	var appender encoding.BinaryAppender = uuid
+	dst, err := appender.AppendBinary([]byte{ 0xFF })
+	assert.NoError(t, err)
+	data, _ := uuid.MarshalBinary()
+	assert.Equal(t, append([]byte{ 0xFF }, data...), dst)
+
+	buf := make([]byte, 0, 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = uuid.AppendBinary(buf[:0])
+	})
+	assert.Equal(t, float64(0), allocs)
+	assert.Equal(t, data, buf)
+
+	var textAppender encoding.TextAppender = uuid
+	dst, err = textAppender.AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.String(), string(dst))
+
+}
@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/bits"
+	"time"
+)
+
+/**
+	Gets the next UUID treating it as 128-bit unsigned integer in the Compare order
+
+	Carry goes from least to most significant bits, the largest UUID wraps around to Empty;
+	version and variant are not kept, so the result is the exclusive bound of the byte range
+ */
+
+func (this UUID) Next() UUID {
+	lo, carry := bits.Add64(this.leastSigBits, 1, 0)
+	hi, _ := bits.Add64(this.mostSigBits, 0, carry)
+	return UUID{ mostSigBits: hi, leastSigBits: lo }
+}
+
+/**
+	Gets the previous UUID treating it as 128-bit unsigned integer in the Compare order
+
+	Borrow goes from least to most significant bits, Empty wraps around to the largest UUID;
+	version and variant are not kept
+ */
+
+func (this UUID) Prev() UUID {
+	lo, borrow := bits.Sub64(this.leastSigBits, 1, 0)
+	hi, _ := bits.Sub64(this.mostSigBits, 0, borrow)
+	return UUID{ mostSigBits: hi, leastSigBits: lo }
+}
+
+/**
+	Shifts embedded timestamp of time-based versions 1, 6 and 7 by the duration keeping the rest of UUID
+
+	Duration is truncated to the precision of the version: 100 nanoseconds for v1 and v6, milliseconds for v7,
+	so sub-millisecond durations do not move v7 timestamps; results out of the range of the version saturate
+	at the range bounds: 60-bit timestamp of v1 and v6, 48-bit unix milliseconds of v7
+
+	UUIDs without the embedded timestamp are returned as is
+ */
+
+func (this UUID) AddDuration(d time.Duration) UUID {
+	uuid := this
+	switch this.Version() {
+	case TimebasedVer1:
+		uuid.SetTime100NanosUnsigned(addSaturated(this.Time100NanosUnsigned(), int64(d / 100), maxTime100Nanos))
+	case ReorderedTimebasedVer6:
+		time100Nanos, _ := this.embeddedUnixTime100Nanos()
+		uuid.mostSigBits = reorderedTimebasedBits(addSaturated(uint64(time100Nanos + num100NanosSinceUUIDEpoch), int64(d / 100), maxTime100Nanos))
+	case UnixTimebasedVer7:
+		millis := addSaturated(this.mostSigBits >> 16, d.Milliseconds(), uint64(1) << 48 - 1)
+		uuid.mostSigBits = millis << 16 | this.mostSigBits & 0xFFFF
+	}
+	return uuid
+}
+
+/**
+	Adds signed delta to the value in range [0, max] saturating at the range bounds
+ */
+
+func addSaturated(value uint64, delta int64, max uint64) uint64 {
+	if delta < 0 {
+		if uint64(-delta) > value {
+			return 0
+		}
+		return value - uint64(-delta)
+	}
+	if uint64(delta) > max - value {
+		return max
+	}
+	return value + uint64(delta)
+}
+
+/**
+	Gets duration between embedded timestamps of time-based UUIDs, positive if b is later than a
+
+	Versions could be mixed, return 0 if any of UUIDs has no embedded timestamp
+ */
+
+func DurationBetween(a, b UUID) time.Duration {
+	aTime, aOk := a.embeddedUnixTime100Nanos()
+	bTime, bOk := b.embeddedUnixTime100Nanos()
+	if !aOk || !bOk {
+		return 0
+	}
+	return time.Duration(bTime - aTime) * 100
+}
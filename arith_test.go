@@ -0,0 +1,115 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextPrev(t *testing.T) {
+
+	uuid, _ := Parse("00000000-0000-0000-ffff-ffffffffffff")
+	assert.Equal(t, "00000000-0000-0001-0000-000000000000", uuid.Next().String())
+	assert.Equal(t, uuid, uuid.Next().Prev())
+
+	uuid, _ = Parse("00000000-0000-0000-0000-000000000005")
+	assert.Equal(t, "00000000-0000-0000-0000-000000000006", uuid.Next().String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000004", uuid.Prev().String())
+
+	max, _ := Parse("ffffffff-ffff-ffff-ffff-ffffffffffff")
+	assert.Equal(t, Empty, max.Next())
+	assert.Equal(t, max, Empty.Prev())
+
+	for i := 0; i != 100; i = i + 1 {
+		uuid = CreateUUID(rand.Int63() - rand.Int63(), rand.Int63() - rand.Int63())
+		if uuid != max {
+			assert.True(t, uuid.Less(uuid.Next()))
+		}
+		if uuid != Empty {
+			assert.True(t, uuid.Prev().Less(uuid))
+		}
+		assert.Equal(t, uuid, uuid.Prev().Next())
+	}
+
+}
+
+func TestAddDuration(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	v1.SetCounter(12345)
+
+	later := v1.AddDuration(90 * time.Minute)
+	assert.Equal(t, now.Add(90 * time.Minute), later.Time().UTC())
+	assert.Equal(t, TimebasedVer1, later.Version())
+	assert.Equal(t, int64(12345), later.Counter())
+	assert.Equal(t, 90 * time.Minute, DurationBetween(v1, later))
+	assert.Equal(t, -90 * time.Minute, DurationBetween(later, v1))
+	assert.Equal(t, v1, later.AddDuration(-90 * time.Minute))
+
+	v6, _ := MinUUIDForTime(now, ReorderedTimebasedVer6)
+	v6Later := v6.AddDuration(1500 * time.Nanosecond)
+	assert.Equal(t, ReorderedTimebasedVer6, v6Later.Version())
+	assert.Equal(t, 1500 * time.Nanosecond, DurationBetween(v6, v6Later))
+	assert.Equal(t, v6.leastSigBits, v6Later.leastSigBits)
+
+	v7, _ := MaxUUIDForTime(now, UnixTimebasedVer7)
+	v7Later := v7.AddDuration(time.Second + time.Microsecond)
+	assert.Equal(t, UnixTimebasedVer7, v7Later.Version())
+	assert.Equal(t, time.Second, DurationBetween(v7, v7Later))
+	assert.Equal(t, v7.mostSigBits & 0xFFFF, v7Later.mostSigBits & 0xFFFF)
+	assert.Equal(t, v7, v7.AddDuration(999 * time.Microsecond))
+
+	// saturation at the range bounds
+
+	assert.Equal(t, uint64(0), v7.AddDuration(-60 * 365 * 24 * time.Hour).mostSigBits >> 16)
+	assert.Equal(t, UnixTimebasedVer7, v7.AddDuration(math.MinInt64).Version())
+
+	assert.Equal(t, uint64(0), v1.AddDuration(math.MinInt64).AddDuration(math.MinInt64).Time100NanosUnsigned())
+	far := v1
+	for i := 0; i != 20; i = i + 1 {
+		far = far.AddDuration(math.MaxInt64)
+	}
+	assert.Equal(t, maxTime100Nanos, far.Time100NanosUnsigned())
+
+	far = v7
+	for i := 0; i != 40; i = i + 1 {
+		far = far.AddDuration(math.MaxInt64)
+	}
+	assert.Equal(t, uint64(1) << 48 - 1, far.mostSigBits >> 16)
+	assert.Equal(t, v7.mostSigBits & 0xFFFF, far.mostSigBits & 0xFFFF)
+	assert.Equal(t, uint64(0), v6.AddDuration(math.MinInt64).AddDuration(math.MinInt64).Time100NanosUnsigned())
+
+	// mixed versions
+
+	assert.Equal(t, time.Duration(0), DurationBetween(v1, v6))
+	assert.Equal(t, time.Duration(0), DurationBetween(v1, v7))
+
+	v4 := NewUUID(RandomlyGeneratedVer4)
+	assert.Equal(t, v4, v4.AddDuration(time.Hour))
+	assert.Equal(t, time.Duration(0), DurationBetween(v1, v4))
+
+}
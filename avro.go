@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+)
+
+const (
+
+	/**
+		Avro schema of UUID stored as fixed(16)
+	 */
+
+	AvroFixedSchema = `{"type":"fixed","name":"uuid","size":16}`
+
+	/**
+		Avro schema of UUID stored as the uuid logical type on string
+	 */
+
+	AvroStringSchema = `{"type":"string","logicalType":"uuid"}`
+)
+
+var (
+	ErrorWrongAvroLen = errors.New("wrong avro len")
+)
+
+/**
+	Encodes UUID as Avro fixed(16), the same 16 bytes as MarshalBinary
+ */
+
+func (this UUID) MarshalAvroFixed() ([]byte, error) {
+	return this.MarshalBinary()
+}
+
+/**
+	Decodes UUID from Avro fixed(16), data must be exactly 16 bytes
+ */
+
+func (this *UUID) UnmarshalAvroFixed(data []byte) error {
+	if len(data) != 16 {
+		return ErrorWrongAvroLen
+	}
+	return this.UnmarshalBinary(data)
+}
+
+/**
+	Encodes UUID as Avro uuid logical type on string
+
+	Result is zig-zag varint length of the canonical string followed by the string itself
+ */
+
+func (this UUID) MarshalAvroString() ([]byte, error) {
+	dst := make([]byte, 1+36)
+	n := binary.PutVarint(dst, 36)
+	err := this.MarshalTextTo(dst[n:])
+	return dst, err
+}
+
+/**
+	Decodes UUID from Avro uuid logical type on string
+
+	Only the canonical form of 36 characters is accepted, as required by the Avro specification
+
+	return number of consumed bytes, so the next field of the record could be decoded after it
+ */
+
+func (this *UUID) UnmarshalAvroString(data []byte) (int, error) {
+
+	length, n := binary.Varint(data)
+	if n <= 0 {
+		return 0, ErrorWrongAvroLen
+	}
+
+	if length != 36 || len(data) - n < 36 {
+		return 0, ErrorWrongAvroLen
+	}
+
+	end := n + 36
+	uuid, err := ParseBytes(data[n:end])
+	if err != nil {
+		return 0, err
+	}
+
+	*this = uuid
+	return end, nil
+}
@@ -0,0 +1,93 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvro(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	// fixed(16)
+
+	data, err := uuid.MarshalAvroFixed()
+	if err != nil {
+		t.Fatal("fail to MarshalAvroFixed ", err)
+	}
+	assert.Equal(t, 16, len(data))
+
+	var actual UUID
+	err = actual.UnmarshalAvroFixed(data)
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	assert.Equal(t, ErrorWrongAvroLen, actual.UnmarshalAvroFixed(data[:15]))
+	assert.Equal(t, ErrorWrongAvroLen, actual.UnmarshalAvroFixed(append(data, 0)))
+
+	// uuid logical type on string
+
+	data, err = uuid.MarshalAvroString()
+	if err != nil {
+		t.Fatal("fail to MarshalAvroString ", err)
+	}
+	assert.Equal(t, byte(72), data[0])
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", string(data[1:]))
+
+	// next field in the record after uuid
+
+	record := append(data, 0x02)
+
+	actual = Empty
+	n, err := actual.UnmarshalAvroString(record)
+	assert.NoError(t, err)
+	assert.Equal(t, 37, n)
+	assert.True(t, uuid.Equal(actual))
+
+	_, err = actual.UnmarshalAvroString(data[:20])
+	assert.Equal(t, ErrorWrongAvroLen, err)
+
+	_, err = actual.UnmarshalAvroString(nil)
+	assert.Equal(t, ErrorWrongAvroLen, err)
+
+	_, err = actual.UnmarshalAvroString([]byte{0x01})
+	assert.Equal(t, ErrorWrongAvroLen, err)
+
+	// only canonical form
+
+	compact := append([]byte{64}, []byte("534b44a19bf13d20b71ecc4eb77c572f")...)
+	_, err = actual.UnmarshalAvroString(compact)
+	assert.Equal(t, ErrorWrongAvroLen, err)
+
+	braced := append([]byte{76}, []byte("{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}")...)
+	_, err = actual.UnmarshalAvroString(braced)
+	assert.Equal(t, ErrorWrongAvroLen, err)
+
+	_, err = actual.UnmarshalAvroString(append([]byte{72}, []byte("534b44a1-9bf1-3d20-b71e-cc4eb77c572g")...))
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+
+}
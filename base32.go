@@ -0,0 +1,126 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+)
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var crockfordDecoding [256]byte
+
+func init() {
+	for i := range crockfordDecoding {
+		crockfordDecoding[i] = 0xFF
+	}
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		c := crockfordAlphabet[i]
+		crockfordDecoding[c] = byte(i)
+		if 'A' <= c && c <= 'Z' {
+			crockfordDecoding[c + 'a' - 'A'] = byte(i)
+		}
+	}
+	// ambiguous characters
+	crockfordDecoding['O'] = 0
+	crockfordDecoding['o'] = 0
+	crockfordDecoding['I'] = 1
+	crockfordDecoding['i'] = 1
+	crockfordDecoding['L'] = 1
+	crockfordDecoding['l'] = 1
+}
+
+/**
+	Encodes UUID in to 26 characters of Crockford Base32 in upper case
+
+	Used for human-transcribable IDs on printed labels and support tickets
+ */
+
+func (this UUID) EncodeBase32() string {
+	var buf [26]byte
+	this.appendBase32(buf[:0])
+	return string(buf[:])
+}
+
+func (this UUID) appendBase32(dst []byte) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, 26)...)
+	hi, lo := this.mostSigBits, this.leastSigBits
+	for i := n + 25; i >= n; i-- {
+		dst[i] = crockfordAlphabet[lo & 0x1F]
+		lo = lo >> 5 | hi << 59
+		hi >>= 5
+	}
+	return dst
+}
+
+/**
+	Decodes UUID from 26 characters of Crockford Base32
+
+	Decoding is case-insensitive, ambiguous characters I and L are read as 1, O as 0, hyphens are ignored
+ */
+
+func DecodeBase32(s string) (UUID, error) {
+	return decodeBase32([]byte(s))
+}
+
+func decodeBase32(src []byte) (UUID, error) {
+
+	var hi, lo uint64
+	digits := 0
+
+	for i, c := range src {
+
+		if c == '-' {
+			continue
+		}
+
+		v := crockfordDecoding[c]
+		if v == 0xFF {
+			return Empty, invalidFormat(src, i, fmt.Sprintf("invalid base32 character %q", c))
+		}
+
+		if digits == 0 && v > 7 {
+			return Empty, invalidFormat(src, i, "base32 UUID overflow")
+		}
+
+		digits++
+		if digits > 26 {
+			return Empty, ErrorWrongLen
+		}
+
+		hi = hi << 5 | lo >> 59
+		lo = lo << 5 | uint64(v)
+	}
+
+	if digits != 26 {
+		return Empty, ErrorWrongLen
+	}
+
+	return UUID{hi, lo}, nil
+}
+
+func isBase32Text(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if crockfordDecoding[s[i]] == 0xFF {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase32(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	encoded := uuid.EncodeBase32()
+	assert.Equal(t, "2K9D2A36ZH7MGBE7PC9TVQRNSF", encoded)
+	assert.Equal(t, Base32Format, DetectFormat(encoded))
+
+	for _, s := range []string {
+		"2K9D2A36ZH7MGBE7PC9TVQRNSF",
+		"2k9d2a36zh7mgbe7pc9tvqrnsf",
+		"2K9D-2A36-ZH7M-GBE7-PC9T-VQRN-SF",
+	} {
+		actual, err := DecodeBase32(s)
+		assert.NoError(t, err, s)
+		assert.True(t, uuid.Equal(actual), s)
+	}
+
+	assert.Equal(t, "00000000000000000000000000", Empty.EncodeBase32())
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", CreateUUID(-1, -1).EncodeBase32())
+
+	// ambiguous characters
+
+	actual, err := DecodeBase32("OOOOOOOOOOOOOOOOOOOOOOOOOI")
+	assert.NoError(t, err)
+	assert.True(t, CreateUUID(0, 1).Equal(actual))
+
+	actual, err = DecodeBase32("0000000000000000000000000l")
+	assert.NoError(t, err)
+	assert.True(t, CreateUUID(0, 1).Equal(actual))
+
+	// invalid input
+
+	_, err = DecodeBase32("8ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	assert.Error(t, err)
+
+	_, err = DecodeBase32("2K9D2A36ZH7MGBE7PC9TVQRNSU")
+	assert.Error(t, err)
+
+	_, err = DecodeBase32("2K9D2A36ZH7MGBE7PC9TVQRNS")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = DecodeBase32("2K9D2A36ZH7MGBE7PC9TVQRNSFF")
+	assert.Equal(t, ErrorWrongLen, err)
+
+}
@@ -0,0 +1,146 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Decoding [256]byte
+
+func init() {
+	for i := range base58Decoding {
+		base58Decoding[i] = 0xFF
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		base58Decoding[base58Alphabet[i]] = byte(i)
+	}
+}
+
+/**
+	Encodes UUID in to Base58 with Bitcoin alphabet
+
+	Leading zero bytes are encoded as '1', so the result has up to 22 characters
+ */
+
+func (this UUID) EncodeBase58() string {
+	var buf [22]byte
+	return string(this.appendBase58(buf[:0]))
+}
+
+func (this UUID) appendBase58(dst []byte) []byte {
+
+	data := this.Array()
+
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	// big-endian digits in base 58
+	var digits [22]byte
+	length := 0
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := 0; i < length; i++ {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits[length] = byte(carry % 58)
+			length++
+			carry /= 58
+		}
+	}
+
+	for i := 0; i < zeros; i++ {
+		dst = append(dst, '1')
+	}
+	for i := length - 1; i >= 0; i-- {
+		dst = append(dst, base58Alphabet[digits[i]])
+	}
+	return dst
+}
+
+/**
+	Decodes UUID from Base58 with Bitcoin alphabet
+
+	Ambiguous characters 0, O, I and l are rejected like any other character outside of the alphabet
+ */
+
+func DecodeBase58(s string) (UUID, error) {
+	return decodeBase58([]byte(s))
+}
+
+func decodeBase58(src []byte) (UUID, error) {
+
+	if len(src) == 0 || len(src) > 22 {
+		return Empty, ErrorWrongLen
+	}
+
+	var data [16]byte
+	zeros := 0
+	for zeros < len(src) && src[zeros] == '1' {
+		zeros++
+	}
+
+	// little-endian bytes of the value
+	var value [16]byte
+	length := 0
+	for i, c := range src[zeros:] {
+
+		v := base58Decoding[c]
+		if v == 0xFF {
+			switch c {
+			case '0', 'O', 'I', 'l':
+				return Empty, invalidFormat(src, zeros + i, fmt.Sprintf("ambiguous base58 character %q", c))
+			default:
+				return Empty, invalidFormat(src, zeros + i, fmt.Sprintf("invalid base58 character %q", c))
+			}
+		}
+
+		carry := int(v)
+		for j := 0; j < length; j++ {
+			carry += int(value[j]) * 58
+			value[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			if length == len(value) {
+				return Empty, invalidFormat(src, zeros + i, "base58 UUID overflow")
+			}
+			value[length] = byte(carry)
+			length++
+			carry >>= 8
+		}
+	}
+
+	if zeros + length != 16 {
+		return Empty, ErrorWrongLen
+	}
+
+	for i := 0; i < length; i++ {
+		data[15 - i] = value[i]
+	}
+
+	return FromArray(data), nil
+}
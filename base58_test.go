@@ -0,0 +1,85 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase58(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	assert.Equal(t, "BHZRWp4JQS8cYJvjRB6pEz", uuid.EncodeBase58())
+	assert.Equal(t, "YcVfxkQb6JRzqk5kF2tNLv", CreateUUID(-1, -1).EncodeBase58())
+	assert.Equal(t, "1111111111111111", Empty.EncodeBase58())
+	assert.Equal(t, "11111111111111mL", CreateUUID(0, 0x0a0b).EncodeBase58())
+
+	for _, s := range []string { "BHZRWp4JQS8cYJvjRB6pEz", "YcVfxkQb6JRzqk5kF2tNLv", "1111111111111111", "11111111111111mL" } {
+		actual, err := DecodeBase58(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, s, actual.EncodeBase58())
+	}
+
+	for i := 0; i != 1000; i = i + 1 {
+		uuid := CreateUUID(rand.Int63() >> uint(rand.Intn(63)), rand.Int63() - rand.Int63())
+		actual, err := DecodeBase58(uuid.EncodeBase58())
+		assert.NoError(t, err)
+		assert.True(t, uuid.Equal(actual))
+	}
+
+	// ambiguous and invalid characters
+
+	_, err = DecodeBase58("BHZRWp4JQS8cYJvjRB6pE0")
+	assert.EqualError(t, err, "ambiguous base58 character '0' at position 21 in \"BHZRWp4JQS8cYJvjRB6pE0\"")
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+
+	var formatErr *InvalidFormatError
+	assert.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, 21, formatErr.Pos)
+
+	_, err = DecodeBase58("BHZRWp4JQS8cYJvjRBlpEz")
+	assert.EqualError(t, err, "ambiguous base58 character 'l' at position 18 in \"BHZRWp4JQS8cYJvjRBlpEz\"")
+
+	_, err = DecodeBase58("BHZRWp4JQS8cYJvjRB-pEz")
+	assert.EqualError(t, err, "invalid base58 character '-' at position 18 in \"BHZRWp4JQS8cYJvjRB-pEz\"")
+
+	// length and overflow
+
+	_, err = DecodeBase58("")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = DecodeBase58("mL")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = DecodeBase58("zzzzzzzzzzzzzzzzzzzzzz")
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+
+	_, err = DecodeBase58("11111111111111111")
+	assert.Equal(t, ErrorWrongLen, err)
+
+}
@@ -0,0 +1,81 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+var base64Encoding = base64.RawURLEncoding.Strict()
+
+/**
+	Encodes UUID in to 22 characters of unpadded URL-safe base64
+
+	Used in compact APIs and JWT claims
+ */
+
+func (this UUID) EncodeBase64() string {
+	var buf [22]byte
+	this.appendBase64(buf[:0])
+	return string(buf[:])
+}
+
+func (this UUID) appendBase64(dst []byte) []byte {
+	var data [16]byte
+	this.MarshalBinaryTo(data[:])
+	n := len(dst)
+	dst = append(dst, make([]byte, 22)...)
+	base64Encoding.Encode(dst[n:], data[:])
+	return dst
+}
+
+/**
+	Decodes UUID from 22 characters of unpadded URL-safe base64
+
+	Padding, line breaks, standard base64 alphabet and non-zero trailing bits are rejected
+ */
+
+func DecodeBase64(s string) (UUID, error) {
+	return decodeBase64([]byte(s))
+}
+
+func decodeBase64(src []byte) (UUID, error) {
+
+	if len(src) != 22 {
+		return Empty, ErrorWrongLen
+	}
+
+	for i, c := range src {
+		if !isBase64URLText(string(c)) {
+			return Empty, invalidFormat(src, i, fmt.Sprintf("invalid base64 character %q", c))
+		}
+	}
+
+	var data [16]byte
+	if _, err := base64Encoding.Decode(data[:], src); err != nil {
+		pos := len(src) - 1
+		if corrupt, ok := err.(base64.CorruptInputError); ok {
+			pos = int(corrupt)
+		}
+		return Empty, invalidFormat(src, pos, "non-zero trailing bits of base64")
+	}
+
+	return FromArray(data), nil
+}
@@ -0,0 +1,69 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase64(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	encoded := uuid.EncodeBase64()
+	assert.Equal(t, "U0tEoZvxPSC3HsxOt3xXLw", encoded)
+	assert.Equal(t, Base64Format, DetectFormat(encoded))
+
+	actual, err := DecodeBase64(encoded)
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	max := CreateUUID(-1, -1)
+	assert.Equal(t, "_____________________w", max.EncodeBase64())
+	actual, err = DecodeBase64("_____________________w")
+	assert.NoError(t, err)
+	assert.True(t, max.Equal(actual))
+
+	assert.Equal(t, "AAAAAAAAAAAAAAAAAAAAAA", Empty.EncodeBase64())
+
+	// strict validation
+
+	_, err = DecodeBase64("U0tEoZvxPSC3HsxOt3xXLw==")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = DecodeBase64("U0tEoZvxPSC3HsxOt3xXL")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = DecodeBase64("U0tEoZvxPSC3HsxOt3xX+w")
+	assert.Error(t, err)
+
+	_, err = DecodeBase64("U0tEoZvxPSC3HsxOt3xX\nw")
+	assert.Error(t, err)
+
+	// non-zero trailing bits
+
+	_, err = DecodeBase64("U0tEoZvxPSC3HsxOt3xXLx")
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,198 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	minVariantIETFBits = variantIETFBits
+	maxVariantIETFBits = uint64(0xBFFFFFFFFFFFFFFF)
+)
+
+var (
+	ErrorTimeOutOfRange = errors.New("time is out of UUID range")
+)
+
+/**
+	Creates the smallest UUID of the time-based version whose embedded timestamp equals the time
+
+	Supported versions are TimebasedVer1, ReorderedTimebasedVer6 and UnixTimebasedVer7,
+	time is truncated to the precision of the version: 100 nanoseconds for v1 and v6, milliseconds for v7
+
+	Time-based UUID (version 1) is the smallest in MarshalSortableBinary order (see SetMinCounter),
+	other versions are the smallest in MarshalBinary order
+ */
+
+func MinUUIDForTime(t time.Time, version Version) (UUID, error) {
+	return uuidForTime(t, version, false)
+}
+
+/**
+	Creates the largest UUID of the time-based version whose embedded timestamp equals the time
+
+	Supported versions are TimebasedVer1, ReorderedTimebasedVer6 and UnixTimebasedVer7,
+	time is truncated to the precision of the version: 100 nanoseconds for v1 and v6, milliseconds for v7
+
+	Time-based UUID (version 1) is the largest in MarshalSortableBinary order (see SetMaxCounter),
+	other versions are the largest in MarshalBinary order
+ */
+
+func MaxUUIDForTime(t time.Time, version Version) (UUID, error) {
+	return uuidForTime(t, version, true)
+}
+
+/**
+	Creates Time-based UUID (version 1) with IETF variant for the time and the random counter
+
+	Counter comes from the non-cryptographic generator of math/rand/v2, use UUIDAtWithCounter
+	or TimebasedGenerator when uniqueness needs the coordinated node and clock sequence
+ */
+
+func UUIDAt(t time.Time) UUID {
+	return UUIDAtWithCounter(t, rand.Int64())
+}
+
+/**
+	Creates Time-based UUID (version 1) with IETF variant for the time and the counter
+
+	Counter is sanitized to the range [0 to 3fffffffffffffff] like in SetCounter
+ */
+
+func UUIDAtWithCounter(t time.Time, counter int64) UUID {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(t)
+	uuid.SetCounter(counter)
+	return uuid
+}
+
+func uuidForTime(t time.Time, version Version, max bool) (uuid UUID, err error) {
+
+	switch version {
+
+	case TimebasedVer1, ReorderedTimebasedVer6:
+		time100Nanos, err := time100NanosOf(t)
+		if err != nil {
+			return Empty, err
+		}
+		uuid = NewUUID(TimebasedVer1)
+		uuid.SetTime100NanosUnsigned(time100Nanos)
+		if version == TimebasedVer1 {
+			if max {
+				uuid.SetMaxCounter()
+			} else {
+				uuid.SetMinCounter()
+			}
+			return uuid, nil
+		}
+		uuid.mostSigBits = reorderedTimebasedBits(time100Nanos)
+
+	case UnixTimebasedVer7:
+		millis := t.UnixMilli()
+		if millis < 0 || millis >= int64(1) << 48 {
+			return Empty, ErrorTimeOutOfRange
+		}
+		uuid.mostSigBits = uint64(millis) << 16 | unixTimebasedVersionBits
+		if max {
+			uuid.mostSigBits |= subMillisFractionBits
+		}
+
+	default:
+		return Empty, errors.Errorf("unsupported time-based version %s", version.String())
+	}
+
+	uuid.leastSigBits = minVariantIETFBits
+	if max {
+		uuid.leastSigBits = maxVariantIETFBits
+	}
+	return uuid, nil
+}
+
+/**
+	Gets 60-bit time in 100 nanoseconds since midnight, October 15, 1582 UTC.
+ */
+
+func time100NanosOf(t time.Time) (uint64, error) {
+	seconds := t.Unix() + num100NanosSinceUUIDEpoch / one100NanosInSecond
+	if seconds < 0 || seconds > int64(maxTime100Nanos) / one100NanosInSecond {
+		return 0, ErrorTimeOutOfRange
+	}
+	time100Nanos := uint64(seconds * one100NanosInSecond + int64(t.Nanosecond() / 100))
+	if time100Nanos > maxTime100Nanos {
+		return 0, ErrorTimeOutOfRange
+	}
+	return time100Nanos, nil
+}
+
+/**
+	Gets most significant bits of Reordered Time-based UUID (version 6): time_high, time_mid, version and time_low
+ */
+
+func reorderedTimebasedBits(time100Nanos uint64) uint64 {
+	return time100Nanos >> 12 << 16 | uint64(ReorderedTimebasedVer6) << 12 | time100Nanos & 0xFFF
+}
+
+/**
+	Creates bounds of Time-based UUIDs (version 1) for the half-open time interval [start, end)
+
+	UUID is in the interval if lo <= uuid < hi in CompareTimeuuid order, the same order as
+	MarshalSortableBinary and MarshalSortableText outputs, so bounds are ready to use in Cassandra
+	range predicates (>= lo AND < hi) and in KV range scans over sortable keys
+
+	Times out of the UUID range are clamped to the range
+ */
+
+func RangeForInterval(start, end time.Time) (lo, hi UUID) {
+	lo = NewUUID(TimebasedVer1)
+	lo.SetTime100NanosUnsigned(clampTime100Nanos(start))
+	lo.SetMinCounter()
+	hi = NewUUID(TimebasedVer1)
+	hi.SetTime100NanosUnsigned(clampTime100Nanos(end))
+	hi.SetMinCounter()
+	return lo, hi
+}
+
+/**
+	Creates MarshalSortableBinary keys of RangeForInterval bounds for the half-open time interval [start, end)
+
+	Key is in the interval if bytes.Compare(lo, key) <= 0 and bytes.Compare(key, hi) < 0,
+	lo is for the Seek() and hi is for the upper bound of the iterator
+ */
+
+func SortableRangeForInterval(start, end time.Time) (lo, hi []byte) {
+	loUUID, hiUUID := RangeForInterval(start, end)
+	lo, _ = loUUID.MarshalSortableBinary()
+	hi, _ = hiUUID.MarshalSortableBinary()
+	return lo, hi
+}
+
+func clampTime100Nanos(t time.Time) uint64 {
+	time100Nanos, err := time100NanosOf(t)
+	switch {
+	case err == nil:
+		return time100Nanos
+	case t.Unix() < 0:
+		return 0
+	default:
+		return maxTime100Nanos
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDForTime(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 123456789, time.UTC)
+
+	sortable := func(uuid UUID) []byte {
+		data, _ := uuid.MarshalSortableBinary()
+		return data
+	}
+	binary := func(uuid UUID) []byte {
+		data, _ := uuid.MarshalBinary()
+		return data
+	}
+
+	// v1
+
+	min, err := MinUUIDForTime(now, TimebasedVer1)
+	assert.NoError(t, err)
+	max, err := MaxUUIDForTime(now, TimebasedVer1)
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, min.Version())
+	assert.Equal(t, now.Truncate(100), min.Time().UTC())
+	assert.Equal(t, now.Truncate(100), max.Time().UTC())
+
+	for i := 0; i != 100; i = i + 1 {
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetTime(now)
+		uuid.SetCounter(rand.Int63())
+		assert.True(t, bytes.Compare(sortable(min), sortable(uuid)) <= 0)
+		assert.True(t, bytes.Compare(sortable(uuid), sortable(max)) <= 0)
+	}
+
+	// v6
+
+	min, err = MinUUIDForTime(now, ReorderedTimebasedVer6)
+	assert.NoError(t, err)
+	max, err = MaxUUIDForTime(now, ReorderedTimebasedVer6)
+	assert.NoError(t, err)
+	assert.Equal(t, ReorderedTimebasedVer6, min.Version())
+	assert.Equal(t, IETF, min.Variant())
+	assert.Equal(t, ReorderedTimebasedVer6, max.Version())
+	assert.Equal(t, IETF, max.Variant())
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	assert.Equal(t, "1eed7c33-add3-6687-8000-000000000000", min.String())
+	assert.Equal(t, v1.Time100NanosUnsigned(), min.mostSigBits >> 16 << 12 | min.mostSigBits & 0xFFF)
+
+	for i := 0; i != 100; i = i + 1 {
+		uuid := min
+		uuid.leastSigBits = rand.Uint64() & counterMask | variantIETFBits
+		assert.True(t, bytes.Compare(binary(min), binary(uuid)) <= 0)
+		assert.True(t, bytes.Compare(binary(uuid), binary(max)) <= 0)
+	}
+
+	next, _ := MinUUIDForTime(now.Add(100), ReorderedTimebasedVer6)
+	assert.Equal(t, -1, bytes.Compare(binary(max), binary(next)))
+
+	// v7
+
+	min, err = MinUUIDForTime(now, UnixTimebasedVer7)
+	assert.NoError(t, err)
+	max, err = MaxUUIDForTime(now, UnixTimebasedVer7)
+	assert.NoError(t, err)
+	assert.Equal(t, "018df9e2-b27b-7000-8000-000000000000", min.String())
+	assert.Equal(t, "018df9e2-b27b-7fff-bfff-ffffffffffff", max.String())
+
+	gen := NewUnixTimebasedGenerator()
+	for i := 0; i != 100; i = i + 1 {
+		gen.clock = func() time.Time { return now.Truncate(time.Millisecond).Add(time.Duration(rand.Int63n(int64(time.Millisecond)))) }
+		gen.lastNanos = 0
+		uuid, err := gen.Next()
+		assert.NoError(t, err)
+		assert.True(t, bytes.Compare(binary(min), binary(uuid)) <= 0)
+		assert.True(t, bytes.Compare(binary(uuid), binary(max)) <= 0)
+	}
+
+	// errors
+
+	_, err = MinUUIDForTime(now, RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+	_, err = MinUUIDForTime(time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC), TimebasedVer1)
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+	_, err = MaxUUIDForTime(time.Date(6000, 1, 1, 0, 0, 0, 0, time.UTC), ReorderedTimebasedVer6)
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+	_, err = MinUUIDForTime(time.Date(1969, 1, 1, 0, 0, 0, 0, time.UTC), UnixTimebasedVer7)
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+}
+
+func TestRangeForInterval(t *testing.T) {
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	lo, hi := RangeForInterval(start, end)
+	assert.Equal(t, start, lo.Time().UTC())
+	assert.Equal(t, end, hi.Time().UTC())
+
+	loKey, hiKey := SortableRangeForInterval(start, end)
+	loText, _ := lo.MarshalSortableText()
+	hiText, _ := hi.MarshalSortableText()
+
+	for _, tc := range []struct {
+		time time.Time
+		in   bool
+	}{
+		{ start, true },
+		{ start.Add(100), true },
+		{ end.Add(-100), true },
+		{ end, false },
+		{ start.Add(-100), false },
+	} {
+		for i := 0; i != 10; i = i + 1 {
+			uuid := NewUUID(TimebasedVer1)
+			uuid.SetTime(tc.time)
+			uuid.SetCounter(rand.Int63())
+
+			assert.Equal(t, tc.in, CompareTimeuuid(lo, uuid) <= 0 && CompareTimeuuid(uuid, hi) < 0, tc.time)
+
+			key, _ := uuid.MarshalSortableBinary()
+			assert.Equal(t, tc.in, bytes.Compare(loKey, key) <= 0 && bytes.Compare(key, hiKey) < 0, tc.time)
+
+			text, _ := uuid.MarshalSortableText()
+			assert.Equal(t, tc.in, bytes.Compare(loText, text) <= 0 && bytes.Compare(text, hiText) < 0, tc.time)
+		}
+	}
+
+	// clamped
+
+	lo, hi = RangeForInterval(time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, uint64(0), lo.Time100NanosUnsigned())
+	assert.Equal(t, maxTime100Nanos, hi.Time100NanosUnsigned())
+
+}
+
+func TestUUIDAt(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789123400, time.UTC)
+
+	uuid := UUIDAtWithCounter(now, 12345)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, now, uuid.Time().UTC())
+	assert.Equal(t, int64(12345), uuid.Counter())
+
+	uuid = UUIDAtWithCounter(now, -1)
+	assert.Equal(t, int64(0x3FFFFFFFFFFFFFFF), uuid.Counter())
+
+	first, second := UUIDAt(now), UUIDAt(now)
+	assert.Equal(t, TimebasedVer1, first.Version())
+	assert.Equal(t, IETF, first.Variant())
+	assert.Equal(t, now, first.Time().UTC())
+	assert.Equal(t, first.Time100Nanos(), second.Time100Nanos())
+	assert.False(t, first.Equal(second))
+	assert.NoError(t, first.Validate())
+
+}
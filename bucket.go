@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"time"
+)
+
+/**
+	Truncates timestamp of Time-based UUID (version 1) to the start of the time bucket
+
+	Buckets are aligned to the unix epoch, so hourly and daily buckets start at UTC hours and days,
+	counter is kept as is; durations less than 100 nanoseconds keep UUID as is
+ */
+
+func (this UUID) TruncateToBucket(d time.Duration) UUID {
+	bucket := int64(d / 100)
+	if bucket <= 0 {
+		return this
+	}
+	uuid := this
+	uuid.SetUnixTime100Nanos(this.BucketKey(d) * bucket)
+	return uuid
+}
+
+/**
+	Gets number of the time bucket since unix epoch of Time-based UUID (version 1)
+
+	Used as a partition key for hourly and daily partitions: all UUIDs in the bucket have the same key,
+	times before unix epoch have negative keys; durations less than 100 nanoseconds return the timestamp
+ */
+
+func (this UUID) BucketKey(d time.Duration) int64 {
+	unixTime100Nanos := this.UnixTime100Nanos()
+	bucket := int64(d / 100)
+	if bucket <= 1 {
+		return unixTime100Nanos
+	}
+	key := unixTime100Nanos / bucket
+	if unixTime100Nanos % bucket < 0 {
+		key--
+	}
+	return key
+}
+
+/**
+	Coarsens the timestamp embedded in time-based UUID to the granularity, the rest of bits are kept intact
+
+	Used to share UUIDs under privacy constraints, e.g. with the hour granularity; timestamps are truncated
+	to the epoch-aligned buckets like in TruncateToBucket for versions 1 and 6, version 7 is truncated to
+	milliseconds with the sub-millisecond fraction cleared; other versions and non-positive granularity keep UUID as is
+ */
+
+func (this UUID) RedactTime(granularity time.Duration) UUID {
+
+	if granularity <= 0 {
+		return this
+	}
+
+	uuid := this
+
+	switch this.Version() {
+
+	case TimebasedVer1:
+		return this.TruncateToBucket(granularity)
+
+	case ReorderedTimebasedVer6:
+		bucket := int64(granularity / 100)
+		if bucket <= 1 {
+			return this
+		}
+		unixTime100Nanos, _ := this.embeddedUnixTime100Nanos()
+		key := unixTime100Nanos / bucket
+		if unixTime100Nanos % bucket < 0 {
+			key--
+		}
+		uuid.mostSigBits = reorderedTimebasedBits(uint64(key * bucket + num100NanosSinceUUIDEpoch))
+
+	case UnixTimebasedVer7:
+		millis := this.mostSigBits >> 16
+		if bucket := uint64(granularity / time.Millisecond); bucket > 1 {
+			millis = millis / bucket * bucket
+		}
+		uuid.mostSigBits = millis << 16 | unixTimebasedVersionBits
+	}
+
+	return uuid
+}
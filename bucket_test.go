@@ -0,0 +1,106 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucket(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789, time.UTC)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(now)
+	uuid.SetCounter(12345)
+
+	hour := uuid.TruncateToBucket(time.Hour)
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), hour.Time().UTC())
+	assert.Equal(t, TimebasedVer1, hour.Version())
+	assert.Equal(t, int64(12345), hour.Counter())
+
+	day := uuid.TruncateToBucket(24 * time.Hour)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), day.Time().UTC())
+
+	assert.Equal(t, now.Unix() / 3600, uuid.BucketKey(time.Hour))
+	assert.Equal(t, uuid.BucketKey(time.Hour), hour.BucketKey(time.Hour))
+
+	next := NewUUID(TimebasedVer1)
+	next.SetTime(time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC))
+	assert.Equal(t, uuid.BucketKey(time.Hour) + 1, next.BucketKey(time.Hour))
+
+	// before unix epoch
+
+	uuid.SetTime(time.Date(1969, 12, 31, 23, 30, 0, 0, time.UTC))
+	assert.Equal(t, int64(-1), uuid.BucketKey(time.Hour))
+	assert.Equal(t, time.Date(1969, 12, 31, 23, 0, 0, 0, time.UTC), uuid.TruncateToBucket(time.Hour).Time().UTC())
+
+	// too small buckets
+
+	uuid.SetTime(now)
+	assert.True(t, uuid.Equal(uuid.TruncateToBucket(0)))
+	assert.True(t, uuid.Equal(uuid.TruncateToBucket(time.Nanosecond)))
+	assert.Equal(t, uuid.UnixTime100Nanos(), uuid.BucketKey(0))
+
+}
+
+func TestRedactTime(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789000, time.UTC)
+	hour := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	v1.SetCounter(12345)
+
+	redacted := v1.RedactTime(time.Hour)
+	assert.Equal(t, hour, redacted.Time().UTC())
+	assert.Equal(t, v1.Counter(), redacted.Counter())
+
+	v6, _ := MinUUIDForTime(now, ReorderedTimebasedVer6)
+	v6.leastSigBits = 0xABCDEF0123456789 & counterMask | variantIETFBits
+
+	redacted = v6.RedactTime(time.Hour)
+	assert.Equal(t, ReorderedTimebasedVer6, redacted.Version())
+	assert.Equal(t, v6.leastSigBits, redacted.leastSigBits)
+	unixTime100Nanos, _ := redacted.embeddedUnixTime100Nanos()
+	assert.Equal(t, hour.UnixNano() / 100, unixTime100Nanos)
+
+	v7, _ := MaxUUIDForTime(now, UnixTimebasedVer7)
+
+	redacted = v7.RedactTime(time.Hour)
+	assert.Equal(t, UnixTimebasedVer7, redacted.Version())
+	assert.Equal(t, v7.leastSigBits, redacted.leastSigBits)
+	assert.Equal(t, hour.UnixMilli() << 16 | int64(unixTimebasedVersionBits), int64(redacted.mostSigBits))
+
+	// sub-millisecond fraction is cleared
+	redacted = v7.RedactTime(time.Microsecond)
+	assert.Equal(t, now.UnixMilli() << 16 | int64(unixTimebasedVersionBits), int64(redacted.mostSigBits))
+
+	// other versions and non-positive granularity are kept as is
+
+	v4 := randomUUIDFromWords(0x0123456789ABCDEF, 0xFEDCBA9876543210)
+	assert.True(t, v4.Equal(v4.RedactTime(time.Hour)))
+	assert.True(t, v1.Equal(v1.RedactTime(0)))
+	assert.True(t, v7.Equal(v7.RedactTime(-time.Hour)))
+
+}
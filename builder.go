@@ -0,0 +1,275 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+var (
+	ErrorInvalidField = errors.New("invalid UUID field")
+)
+
+/**
+	Fluent builder of UUIDs that validates field ranges instead of silently truncating them like setters
+
+	uuid, err := NewBuilder().Version(TimebasedVer1).Time(t).Node(n).ClockSequence(c).Build()
+
+	Fields are checked against the version in Build: time for versions 1, 2, 6 and 7,
+	node and clock sequence for versions 1, 2 and 6, counter for versions 1 and 7,
+	domain and identifier for version 2, name for versions 3, 5 and 8;
+	version 4 gets random payload
+ */
+
+type Builder struct {
+	version       Version
+	t             *time.Time
+	node          *int64
+	clockSequence *int
+	counter       *int64
+	domain        *Domain
+	id            *uint32
+	namespace     UUID
+	name          []byte
+}
+
+/**
+	Creates new empty builder
+ */
+
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+/**
+	Sets version, required
+ */
+
+func (this *Builder) Version(version Version) *Builder {
+	this.version = version
+	return this
+}
+
+/**
+	Sets time of the time-based versions
+ */
+
+func (this *Builder) Time(t time.Time) *Builder {
+	this.t = &t
+	return this
+}
+
+/**
+	Sets 48-bit node in range [0, 0xFFFFFFFFFFFF]
+ */
+
+func (this *Builder) Node(node int64) *Builder {
+	this.node = &node
+	return this
+}
+
+/**
+	Sets clock sequence in range [0, 0x3FFF], [0, 0x3F] for version 2
+ */
+
+func (this *Builder) ClockSequence(clockSequence int) *Builder {
+	this.clockSequence = &clockSequence
+	return this
+}
+
+/**
+	Sets counter in range [0, 0x3FFFFFFFFFFFFFFF], it is exclusive with node and clock sequence
+
+	Version 7 stores counter in the 62 bits of rand_b
+ */
+
+func (this *Builder) Counter(counter int64) *Builder {
+	this.counter = &counter
+	return this
+}
+
+/**
+	Sets local domain of version 2
+ */
+
+func (this *Builder) Domain(domain Domain) *Builder {
+	this.domain = &domain
+	return this
+}
+
+/**
+	Sets local identifier of version 2
+ */
+
+func (this *Builder) ID(id uint32) *Builder {
+	this.id = &id
+	return this
+}
+
+/**
+	Sets name in the name space of name-based versions 3, 5 and 8, see NameUUID
+ */
+
+func (this *Builder) Name(namespace UUID, name []byte) *Builder {
+	this.namespace = namespace
+	this.name = name
+	return this
+}
+
+/**
+	Validates fields and builds UUID
+ */
+
+func (this *Builder) Build() (UUID, error) {
+
+	version := this.version
+
+	allowed := map[string]bool{}
+	switch version {
+	case TimebasedVer1:
+		allowed = map[string]bool{ "time": true, "node": true, "clock sequence": true, "counter": true }
+	case DCESecurityVer2:
+		allowed = map[string]bool{ "time": true, "node": true, "clock sequence": true, "domain": true, "id": true }
+	case NamebasedVer3, NamebasedVer5, CustomVer8:
+		allowed = map[string]bool{ "name": true }
+	case RandomlyGeneratedVer4:
+	case ReorderedTimebasedVer6:
+		allowed = map[string]bool{ "time": true, "node": true, "clock sequence": true }
+	case UnixTimebasedVer7:
+		allowed = map[string]bool{ "time": true, "counter": true }
+	default:
+		return Empty, errors.Wrapf(ErrorInvalidField, "unsupported version %s", version.String())
+	}
+
+	fields := []struct {
+		name string
+		set  bool
+	}{
+		{ "time", this.t != nil },
+		{ "node", this.node != nil },
+		{ "clock sequence", this.clockSequence != nil },
+		{ "counter", this.counter != nil },
+		{ "domain", this.domain != nil },
+		{ "id", this.id != nil },
+		{ "name", this.name != nil },
+	}
+
+	for _, field := range fields {
+		if field.set && !allowed[field.name] {
+			return Empty, errors.Wrapf(ErrorInvalidField, "%s is not supported by %s", field.name, version.String())
+		}
+		if !field.set && allowed[field.name] && (field.name == "time" || field.name == "name") {
+			return Empty, errors.Wrapf(ErrorInvalidField, "%s is required by %s", field.name, version.String())
+		}
+	}
+
+	if this.counter != nil && (this.node != nil || this.clockSequence != nil) {
+		return Empty, errors.Wrap(ErrorInvalidField, "counter is exclusive with node and clock sequence")
+	}
+
+	if this.node != nil && (*this.node < 0 || *this.node > nodeMask) {
+		return Empty, errors.Wrapf(ErrorInvalidField, "node %#x is out of range [0, %#x]", *this.node, nodeMask)
+	}
+
+	maxClockSequence := clockSequenceBits
+	if version == DCESecurityVer2 {
+		maxClockSequence = dceClockSequenceBits
+	}
+	if this.clockSequence != nil && (*this.clockSequence < 0 || *this.clockSequence > maxClockSequence) {
+		return Empty, errors.Wrapf(ErrorInvalidField, "clock sequence %#x is out of range [0, %#x]", *this.clockSequence, maxClockSequence)
+	}
+
+	if this.counter != nil && (*this.counter < 0 || uint64(*this.counter) > counterMask) {
+		return Empty, errors.Wrapf(ErrorInvalidField, "counter %#x is out of range [0, %#x]", *this.counter, counterMask)
+	}
+
+	if this.t != nil {
+		if _, err := MinUUIDForTime(*this.t, timeVersionOf(version)); err != nil {
+			return Empty, errors.Wrapf(ErrorInvalidField, "time %s is out of range of %s", this.t.UTC().Format(time.RFC3339Nano), version.String())
+		}
+	}
+
+	var node, counter int64
+	var clockSequence int
+	if this.node != nil {
+		node = *this.node
+	}
+	if this.clockSequence != nil {
+		clockSequence = *this.clockSequence
+	}
+	if this.counter != nil {
+		counter = *this.counter
+	}
+
+	switch version {
+
+	case TimebasedVer1:
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetTime(*this.t)
+		if this.counter != nil {
+			uuid.SetCounter(counter)
+		} else {
+			uuid.SetClockSequence(clockSequence)
+			uuid.SetNode(node)
+		}
+		return uuid, nil
+
+	case DCESecurityVer2:
+		var domain Domain
+		var id uint32
+		if this.domain != nil {
+			domain = *this.domain
+		}
+		if this.id != nil {
+			id = *this.id
+		}
+		return CreateDCESecurity(domain, id, *this.t, clockSequence, node), nil
+
+	case NamebasedVer3, NamebasedVer5, CustomVer8:
+		return NameUUID(this.namespace, this.name, version)
+
+	case RandomlyGeneratedVer4:
+		return RandomUUID()
+
+	case ReorderedTimebasedVer6:
+		uuid := NewUUID(ReorderedTimebasedVer6)
+		uuid.SetTime(*this.t)
+		uuid.SetClockSequence(clockSequence)
+		uuid.SetNode(node)
+		return uuid, nil
+
+	default:
+		uuid := NewUUID(UnixTimebasedVer7)
+		uuid.SetTime(*this.t)
+		uuid.leastSigBits = uint64(counter) | variantIETFBits
+		return uuid, nil
+	}
+}
+
+/**
+	Gets version whose time range is checked for the version of builder
+ */
+
+func timeVersionOf(version Version) Version {
+	if version == UnixTimebasedVer7 {
+		return UnixTimebasedVer7
+	}
+	return TimebasedVer1
+}
@@ -0,0 +1,90 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789123400, time.UTC)
+
+	uuid, err := NewBuilder().Version(TimebasedVer1).Time(now).Node(0xABCDEF).ClockSequence(0x1234).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, now, uuid.Time().UTC())
+	assert.Equal(t, int64(0xABCDEF), uuid.Node())
+	assert.Equal(t, 0x1234, uuid.ClockSequence())
+
+	uuid, err = NewBuilder().Version(TimebasedVer1).Time(now).Counter(12345).Build()
+	assert.NoError(t, err)
+	assert.True(t, UUIDAtWithCounter(now, 12345).Equal(uuid))
+
+	uuid, err = NewBuilder().Version(DCESecurityVer2).Time(now).Domain(Group).ID(1000).ClockSequence(0x15).Node(0x123456789ABC).Build()
+	assert.NoError(t, err)
+	assert.True(t, CreateDCESecurity(Group, 1000, now, 0x15, 0x123456789ABC).Equal(uuid))
+
+	uuid, err = NewBuilder().Version(NamebasedVer5).Name(NamespaceDNS, []byte("www.example.com")).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", uuid.String())
+
+	uuid, err = NewBuilder().Version(RandomlyGeneratedVer4).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, RandomlyGeneratedVer4, uuid.Version())
+
+	uuid, err = NewBuilder().Version(ReorderedTimebasedVer6).Time(now).Node(0xABCDEF).ClockSequence(7).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, ReorderedTimebasedVer6, uuid.Version())
+	assert.Equal(t, now, uuid.Time().UTC())
+	assert.Equal(t, int64(0xABCDEF), uuid.Node())
+	assert.Equal(t, 7, uuid.ClockSequence())
+
+	uuid, err = NewBuilder().Version(UnixTimebasedVer7).Time(now).Counter(0x3FFFFFFFFFFFFFFF).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, UnixTimebasedVer7, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, now.UnixMilli(), uuid.UnixTimeMillis())
+	assert.Equal(t, uint64(0xBFFFFFFFFFFFFFFF), uuid.leastSigBits)
+
+	// descriptive errors instead of truncation
+
+	for expected, builder := range map[string]*Builder{
+		"unsupported version BadVersion9: invalid UUID field":                   NewBuilder().Version(UnknownVersion),
+		"time is required by TimebasedVer1: invalid UUID field":                    NewBuilder().Version(TimebasedVer1),
+		"name is required by NamebasedVer3: invalid UUID field":                    NewBuilder().Version(NamebasedVer3),
+		"node is not supported by UnixTimebasedVer7: invalid UUID field":           NewBuilder().Version(UnixTimebasedVer7).Time(now).Node(1),
+		"time is not supported by RandomlyGeneratedVer4: invalid UUID field":       NewBuilder().Version(RandomlyGeneratedVer4).Time(now),
+		"counter is exclusive with node and clock sequence: invalid UUID field":    NewBuilder().Version(TimebasedVer1).Time(now).Node(1).Counter(1),
+		"node 0x1000000000000 is out of range [0, 0xffffffffffff]: invalid UUID field": NewBuilder().Version(TimebasedVer1).Time(now).Node(0x1000000000000),
+		"clock sequence 0x4000 is out of range [0, 0x3fff]: invalid UUID field":    NewBuilder().Version(TimebasedVer1).Time(now).ClockSequence(0x4000),
+		"clock sequence 0x40 is out of range [0, 0x3f]: invalid UUID field":        NewBuilder().Version(DCESecurityVer2).Time(now).ClockSequence(0x40),
+		"counter -0x1 is out of range [0, 0x3fffffffffffffff]: invalid UUID field": NewBuilder().Version(TimebasedVer1).Time(now).Counter(-1),
+		"time 1969-12-31T23:59:59Z is out of range of UnixTimebasedVer7: invalid UUID field": NewBuilder().Version(UnixTimebasedVer7).Time(time.Unix(-1, 0)),
+	} {
+		_, err := builder.Build()
+		assert.True(t, errors.Is(err, ErrorInvalidField))
+		assert.EqualError(t, err, expected)
+	}
+
+}
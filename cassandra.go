@@ -0,0 +1,88 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"time"
+)
+
+/**
+	Creates the lowest Time-based UUID (version 1) for the millisecond of the time, like CQL minTimeuuid()
+
+	Time is truncated to milliseconds, counter is set by SetMinCounter
+ */
+
+func MinTimeUUID(t time.Time) UUID {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(t.UnixMilli())
+	uuid.SetMinCounter()
+	return uuid
+}
+
+/**
+	Creates the highest Time-based UUID (version 1) for the millisecond of the time, like CQL maxTimeuuid()
+
+	Timestamp is set to the last 100-nanosecond tick of the millisecond, counter is set by SetMaxCounter,
+	so the result is greater than any IETF variant UUID of the same millisecond
+ */
+
+func MaxTimeUUID(t time.Time) UUID {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTime100Nanos(t.UnixMilli() * one100NanosInMillis + one100NanosInMillis - 1)
+	uuid.SetMaxCounter()
+	return uuid
+}
+
+/**
+	Compares two UUIDs in the same order as Cassandra compares timeuuid values
+
+	Cassandra orders by the 60-bit timestamp (with version in the top bits) and then by signed byte comparison
+	of clock_seq_and_node, so min and max UUIDs made by MinTimeUUID and MaxTimeUUID bound the millisecond
+
+	return -1 if a < b, 0 if a == b, +1 if a > b
+ */
+
+func CompareTimeuuid(a, b UUID) int {
+
+	aTime, bTime := int64(reorderTimestampBits(a.mostSigBits)), int64(reorderTimestampBits(b.mostSigBits))
+
+	// signed per-byte comparison as a single signed comparison
+	aSeq, bSeq := int64(a.leastSigBits ^ flipSignedBits), int64(b.leastSigBits ^ flipSignedBits)
+
+	switch {
+	case aTime < bTime:
+		return -1
+	case aTime > bTime:
+		return 1
+	case aSeq < bSeq:
+		return -1
+	case aSeq > bSeq:
+		return 1
+	default:
+		return 0
+	}
+}
+
+/**
+	Moves time_hi_and_version to the top, then time_mid and time_low
+ */
+
+func reorderTimestampBits(mostSigBits uint64) uint64 {
+	return mostSigBits << 48 | (mostSigBits << 16) & 0xFFFF00000000 | mostSigBits >> 32
+}
@@ -0,0 +1,115 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMaxTimeUUID(t *testing.T) {
+
+	now := time.UnixMilli(1700000000123).Add(4567 * time.Nanosecond)
+
+	min := MinTimeUUID(now)
+	assert.Equal(t, TimebasedVer1, min.Version())
+	assert.Equal(t, IETF, min.Variant())
+	assert.Equal(t, now.Truncate(time.Millisecond), min.Time())
+	assert.Equal(t, int64(0), min.Counter())
+
+	max := MaxTimeUUID(now)
+	assert.Equal(t, TimebasedVer1, max.Version())
+	assert.Equal(t, now.Truncate(time.Millisecond).Add(time.Millisecond - 100), max.Time())
+	assert.Equal(t, int64(0x3fffffffffffffff), max.Counter())
+
+	minData, _ := min.MarshalSortableBinary()
+	maxData, _ := max.MarshalSortableBinary()
+
+	for i := 0; i != 100; i = i + 1 {
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetTime(now.Truncate(time.Millisecond).Add(time.Duration(i) * 10 * time.Microsecond))
+		uuid.SetCounter(int64(i) * 0x0A3D70A3D70A3D7)
+
+		data, _ := uuid.MarshalSortableBinary()
+		assert.True(t, bytes.Compare(minData, data) <= 0)
+		assert.True(t, bytes.Compare(data, maxData) <= 0)
+	}
+
+	prev, _ := MaxTimeUUID(now.Add(-time.Millisecond)).MarshalSortableBinary()
+	next, _ := MinTimeUUID(now.Add(time.Millisecond)).MarshalSortableBinary()
+	assert.Equal(t, -1, bytes.Compare(prev, minData))
+	assert.Equal(t, -1, bytes.Compare(maxData, next))
+
+}
+
+func TestCompareTimeuuid(t *testing.T) {
+
+	signedCompare := func(a, b []byte) int {
+		for i := range a {
+			if int8(a[i]) != int8(b[i]) {
+				if int8(a[i]) < int8(b[i]) {
+					return -1
+				}
+				return 1
+			}
+		}
+		return 0
+	}
+
+	uuids := make([]UUID, 200)
+	for i := range uuids {
+		uuids[i] = NewUUID(TimebasedVer1)
+		uuids[i].SetTime100Nanos(rand.Int63n(1 << 60))
+		if i % 2 == 1 {
+			uuids[i].SetTime100Nanos(uuids[i-1].Time100Nanos())
+		}
+		uuids[i].SetCounter(rand.Int63())
+	}
+
+	for _, a := range uuids {
+		for _, b := range uuids {
+
+			expected := 0
+			switch {
+			case a.Time100Nanos() < b.Time100Nanos():
+				expected = -1
+			case a.Time100Nanos() > b.Time100Nanos():
+				expected = 1
+			default:
+				aData, _ := a.MarshalBinary()
+				bData, _ := b.MarshalBinary()
+				expected = signedCompare(aData[8:], bData[8:])
+			}
+			assert.Equal(t, expected, CompareTimeuuid(a, b))
+
+			aSortable, _ := a.MarshalSortableBinary()
+			bSortable, _ := b.MarshalSortableBinary()
+			assert.Equal(t, expected, bytes.Compare(aSortable, bSortable))
+		}
+	}
+
+	now := time.Now()
+	assert.Equal(t, -1, CompareTimeuuid(MinTimeUUID(now), MaxTimeUUID(now)))
+	assert.Equal(t, -1, CompareTimeuuid(MaxTimeUUID(now), MinTimeUUID(now.Add(time.Millisecond))))
+
+}
@@ -0,0 +1,336 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+/*
+Command timeuuid generates, inspects and converts UUIDs in shell pipelines
+
+	timeuuid gen [-v 1|4|5|7] [-n count] [-ns dns|url|oid|x500|uuid] [-name name] [-f format]
+	timeuuid inspect [uuid ...]
+	timeuuid convert [-from format] [-to format] [-in file] [-out file] [-checkpoint file] [uuid ...]
+
+UUIDs are read line by line from stdin when they are not given as arguments,
+convert streams 16-byte records for binary and sortable-binary input formats
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"arpabet.pkg.is/timeuuid"
+)
+
+const usage = `usage:
+  timeuuid gen [-v 1|4|5|7] [-n count] [-ns dns|url|oid|x500|uuid] [-name name] [-f format]
+  timeuuid inspect [uuid ...]
+  timeuuid convert [-from format] [-to format] [-in file] [-out file] [-checkpoint file] [uuid ...]
+
+formats: auto (input only), canonical, braced, urn, compact, hex, base64, base32, base58, sortable, binary, sortable-binary
+`
+
+var formats = map[string]timeuuid.Format{
+	"canonical":       timeuuid.CanonicalFormat,
+	"braced":          timeuuid.BracedFormat,
+	"urn":             timeuuid.URNFormat,
+	"compact":         timeuuid.CompactFormat,
+	"hex":             timeuuid.RawHexFormat,
+	"base64":          timeuuid.Base64Format,
+	"base32":          timeuuid.Base32Format,
+	"base58":          timeuuid.Base58Format,
+	"sortable":        timeuuid.SortableTextFormat,
+	"binary":          timeuuid.BinaryFormat,
+	"sortable-binary": timeuuid.SortableBinaryFormat,
+}
+
+var namespaces = map[string]timeuuid.UUID{
+	"dns":  timeuuid.NamespaceDNS,
+	"url":  timeuuid.NamespaceURL,
+	"oid":  timeuuid.NamespaceOID,
+	"x500": timeuuid.NamespaceX500,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	var err error
+
+	switch args[0] {
+	case "gen":
+		err = gen(args[1:], stdout, stderr)
+	case "inspect":
+		err = inspect(args[1:], stdin, stdout, stderr)
+	case "convert":
+		err = convert(args[1:], stdin, stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		err = fmt.Errorf("unknown command %q", args[0])
+	}
+
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "timeuuid: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func gen(args []string, stdout, stderr io.Writer) error {
+
+	flags := newFlagSet("gen", stderr)
+	version := flags.Int("v", 4, "version of UUID: 1, 4, 5 or 7")
+	count := flags.Int("n", 1, "number of UUIDs")
+	ns := flags.String("ns", "url", "name space of version 5: dns, url, oid, x500 or UUID")
+	name := flags.String("name", "", "name of version 5")
+	formatName := flags.String("f", "canonical", "output format")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	format, err := parseFormatName(*formatName)
+	if err != nil {
+		return err
+	}
+
+	var next func() (timeuuid.UUID, error)
+
+	switch *version {
+
+	case 1:
+		generator, err := timeuuid.NewGenerator()
+		if err != nil {
+			return err
+		}
+		next = generator.Next
+
+	case 4:
+		next = timeuuid.RandomUUID
+
+	case 5:
+		namespace, err := parseNamespace(*ns)
+		if err != nil {
+			return err
+		}
+		uuid := timeuuid.NewV5(namespace, *name)
+		next = func() (timeuuid.UUID, error) { return uuid, nil }
+
+	case 7:
+		next = timeuuid.NewUnixTimebasedGenerator().Next
+
+	default:
+		return fmt.Errorf("unsupported version %d", *version)
+	}
+
+	encoder := timeuuid.NewEncoder(stdout, format)
+	for i := 0; i < *count; i++ {
+		uuid, err := next()
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(uuid); err != nil {
+			return err
+		}
+	}
+	return encoder.Flush()
+}
+
+func inspect(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+
+	flags := newFlagSet("inspect", stderr)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(stdout)
+	first := true
+	err := forEachInput(flags.Args(), stdin, func(input string) error {
+		uuid, format, err := timeuuid.ParseAny([]byte(input))
+		if err != nil {
+			return fmt.Errorf("%q: %v", input, err)
+		}
+		if !first {
+			w.WriteByte('\n')
+		}
+		first = false
+		fmt.Fprintf(w, "format:         %s\n", format.String())
+		w.WriteString(uuid.DebugString())
+		return nil
+	})
+	if err != nil {
+		w.Flush()
+		return err
+	}
+	return w.Flush()
+}
+
+func convert(args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+
+	flags := newFlagSet("convert", stderr)
+	fromName := flags.String("from", "auto", "input format, binary and sortable-binary read 16-byte records")
+	toName := flags.String("to", "canonical", "output format, binary and sortable-binary write 16-byte records")
+	inName := flags.String("in", "", "input file instead of arguments and stdin")
+	outName := flags.String("out", "", "output file instead of stdout")
+	checkpointName := flags.String("checkpoint", "", "checkpoint file to resume conversion of -in to -out after restart")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	to, err := parseFormatName(*toName)
+	if err != nil {
+		return err
+	}
+
+	from := timeuuid.UnknownFormat
+	if *fromName != "auto" {
+		if from, err = parseFormatName(*fromName); err != nil {
+			return err
+		}
+	}
+
+	if *checkpointName != "" {
+		if *inName == "" || *outName == "" || from == timeuuid.UnknownFormat {
+			return fmt.Errorf("-checkpoint requires -in, -out and -from")
+		}
+		reencoder := &timeuuid.Reencoder{ From: from, To: to }
+		_, err := reencoder.RunFile(*inName, *outName, *checkpointName)
+		return err
+	}
+
+	inputs := flags.Args()
+	if *inName != "" {
+		file, err := os.Open(*inName)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		stdin, inputs = file, nil
+	}
+
+	if *outName != "" {
+		file, err := os.Create(*outName)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+		stdout = file
+	}
+
+	encoder := timeuuid.NewEncoder(stdout, to)
+	defer func() {
+		if flushErr := encoder.Flush(); err == nil {
+			err = flushErr
+		}
+	}()
+
+	if from != timeuuid.UnknownFormat && len(inputs) == 0 {
+		decoder := timeuuid.NewDecoder(stdin, from)
+		for n := 1; ; n++ {
+			uuid, err := decoder.Decode()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("record %d: %v", n, err)
+			}
+			if err := encoder.Encode(uuid); err != nil {
+				return err
+			}
+		}
+	}
+
+	return forEachInput(inputs, stdin, func(input string) error {
+		var uuid timeuuid.UUID
+		var err error
+		if from == timeuuid.UnknownFormat {
+			uuid, _, err = timeuuid.ParseAny([]byte(input))
+		} else {
+			uuid, err = timeuuid.ParseFormat([]byte(input), from)
+		}
+		if err != nil {
+			return fmt.Errorf("%q: %v", input, err)
+		}
+		return encoder.Encode(uuid)
+	})
+}
+
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet("timeuuid " + name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	return flags
+}
+
+func parseFormatName(name string) (timeuuid.Format, error) {
+	if format, ok := formats[strings.ToLower(name)]; ok {
+		return format, nil
+	}
+	return timeuuid.UnknownFormat, fmt.Errorf("unknown format %q", name)
+}
+
+func parseNamespace(name string) (timeuuid.UUID, error) {
+	if namespace, ok := namespaces[strings.ToLower(name)]; ok {
+		return namespace, nil
+	}
+	return timeuuid.Parse(name)
+}
+
+/**
+	Calls fn for every argument or, without arguments, for every non-empty line of stdin
+ */
+
+func forEachInput(args []string, stdin io.Reader, fn func(string) error) error {
+
+	if len(args) > 0 {
+		for _, arg := range args {
+			if err := fn(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return fmt.Errorf("line %d: %v", n, err)
+		}
+	}
+	return scanner.Err()
+}
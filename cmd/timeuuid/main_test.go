@@ -0,0 +1,187 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"arpabet.pkg.is/timeuuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestGen(t *testing.T) {
+
+	for _, version := range []string{ "1", "4", "7" } {
+		code, out, _ := runCommand("", "gen", "-v", version, "-n", "3")
+		assert.Equal(t, 0, code)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		assert.Equal(t, 3, len(lines))
+		for _, line := range lines {
+			uuid, err := timeuuid.Parse(line)
+			assert.NoError(t, err)
+			assert.Equal(t, version, strconv.Itoa(int(uuid.Version())))
+			assert.NoError(t, uuid.Validate())
+		}
+	}
+
+	code, out, _ := runCommand("", "gen", "-v", "5", "-ns", "dns", "-name", "www.example.com")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2\n", out)
+
+	code, out, _ = runCommand("", "gen", "-v", "5", "-ns", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "-name", "www.example.com", "-f", "compact")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "2ed6657de927568b95e12665a8aea6a2\n", out)
+
+	code, out, _ = runCommand("", "gen", "-f", "binary", "-n", "2")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, 32, len(out))
+
+	code, _, errOut := runCommand("", "gen", "-v", "3")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "timeuuid: unsupported version 3\n", errOut)
+
+	code, _, errOut = runCommand("", "gen", "-f", "xml")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "timeuuid: unknown format \"xml\"\n", errOut)
+
+}
+
+func TestInspect(t *testing.T) {
+
+	code, out, _ := runCommand("", "inspect", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.Equal(t, 0, code)
+	assert.True(t, strings.HasPrefix(out, "format:         CanonicalFormat\nuuid:           6ba7b810-9dad-11d1-80b4-00c04fd430c8\n"))
+	assert.Contains(t, out, "time:           1998-02-04T22:13:53.1511824Z\n")
+
+	code, out, _ = runCommand("6ba7b8109dad11d180b400c04fd430c8\n\nurn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8\n", "inspect")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "format:         CompactFormat\n")
+	assert.Contains(t, out, "\n\nformat:         URNFormat\n")
+
+	code, _, errOut := runCommand("", "inspect", "zz")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut, "\"zz\"")
+
+}
+
+func TestConvert(t *testing.T) {
+
+	code, out, _ := runCommand("", "convert", "-to", "compact", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "{6ba7b811-9dad-11d1-80b4-00c04fd430c8}")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "6ba7b8109dad11d180b400c04fd430c8\n6ba7b8119dad11d180b400c04fd430c8\n", out)
+
+	code, out, _ = runCommand("6ba7b8109dad11d180b400c04fd430c8\n", "convert", "-from", "compact", "-to", "sortable")
+	assert.Equal(t, 0, code)
+	uuid, _ := timeuuid.Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	sortable, _ := uuid.MarshalSortableText()
+	assert.Equal(t, string(sortable) + "\n", out)
+
+	code, out, _ = runCommand(string(sortable) + "\n", "convert", "-to", "base64")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, uuid.EncodeBase64() + "\n", out)
+
+	code, _, _ = runCommand("", "convert", "-from", "compact", "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.Equal(t, 1, code)
+
+	code, _, _ = runCommand("")
+	assert.Equal(t, 2, code)
+
+	code, _, _ = runCommand("", "unknown")
+	assert.Equal(t, 1, code)
+
+}
+
+func TestConvertFiles(t *testing.T) {
+
+	dir := t.TempDir()
+
+	var lines strings.Builder
+	var expected bytes.Buffer
+	for i := 0; i != 100; i = i + 1 {
+		uuid := timeuuid.NewUUID(timeuuid.TimebasedVer1)
+		uuid.SetUnixTimeMillis(int64(1700000000000 + i))
+		uuid.SetCounter(int64(i))
+		lines.WriteString(uuid.String() + "\n")
+		data, _ := uuid.MarshalSortableBinary()
+		expected.Write(data)
+	}
+
+	in := filepath.Join(dir, "ids.txt")
+	assert.NoError(t, os.WriteFile(in, []byte(lines.String()), 0644))
+
+	// canonical lines to sortable binary records
+
+	out := filepath.Join(dir, "ids.bin")
+	code, _, errOut := runCommand("", "convert", "-in", in, "-out", out, "-to", "sortable-binary")
+	assert.Equal(t, 0, code, errOut)
+	actual, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Bytes(), actual)
+
+	// and back from records
+
+	code, text, errOut := runCommand("", "convert", "-in", out, "-from", "sortable-binary")
+	assert.Equal(t, 0, code, errOut)
+	assert.Equal(t, lines.String(), text)
+
+	code, text, _ = runCommand(string(actual), "convert", "-from", "sortable-binary", "-to", "binary")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, 1600, len(text))
+
+	// errors report the position
+
+	code, _, errOut = runCommand(string(actual[:24]), "convert", "-from", "binary")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "timeuuid: record 2: truncated record at offset 16: wrong len\n", errOut)
+
+	code, _, errOut = runCommand("6ba7b810-9dad-11d1-80b4-00c04fd430c8\nzz\n", "convert")
+	assert.Equal(t, 1, code)
+	assert.True(t, strings.HasPrefix(errOut, "timeuuid: line 2: \"zz\""))
+
+	code, _, _ = runCommand("", "convert", "-in", filepath.Join(dir, "missing.txt"))
+	assert.Equal(t, 1, code)
+
+	// resumable conversion with checkpoint
+
+	resumed := filepath.Join(dir, "resumed.bin")
+	checkpoint := filepath.Join(dir, "resumed.checkpoint")
+	code, _, errOut = runCommand("", "convert", "-in", in, "-out", resumed, "-from", "canonical", "-to", "sortable-binary", "-checkpoint", checkpoint)
+	assert.Equal(t, 0, code, errOut)
+	actual, err = os.ReadFile(resumed)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Bytes(), actual)
+	_, err = os.Stat(checkpoint)
+	assert.True(t, os.IsNotExist(err))
+
+	code, _, errOut = runCommand("", "convert", "-in", in, "-to", "sortable-binary", "-checkpoint", checkpoint)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "timeuuid: -checkpoint requires -in, -out and -from\n", errOut)
+
+}
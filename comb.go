@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+	"time"
+)
+
+/**
+	COMB GUID: randomly generated UUID (version 4) with the last 6 bytes replaced by the 48-bit unix time in milliseconds
+
+	SQL Server compares uniqueidentifier starting from the last 6 bytes,
+	so COMB GUIDs are appended at the end of a clustered index
+ */
+
+type CombGenerator struct {
+	mutex      sync.Mutex
+	clock      func() time.Time
+	random     io.Reader
+	lastMillis int64
+}
+
+/**
+	Creates new COMB GUID generator that uses pseudo-random cryptographic generator
+ */
+
+func NewCombGenerator() *CombGenerator {
+	return &CombGenerator{
+		clock:  time.Now,
+		random: rand.Reader,
+	}
+}
+
+/**
+	Issues next COMB GUID
+
+	Time never goes backwards between calls, GUIDs issued in the same millisecond are not ordered
+ */
+
+func (this *CombGenerator) Next() (uuid UUID, err error) {
+
+	done := traceStart(TraceGenerate, "CombGenerator.Next")
+	defer func() { done(err) }()
+
+	var data [16]byte
+	if _, err = io.ReadFull(this.random, data[:10]); err != nil {
+		return Empty, err
+	}
+
+	millis := this.clock().UnixMilli()
+
+	this.mutex.Lock()
+	if millis < this.lastMillis {
+		millis = this.lastMillis
+	}
+	this.lastMillis = millis
+	this.mutex.Unlock()
+
+	return NewComb(data[:10], time.UnixMilli(millis)), nil
+}
+
+/**
+	Creates COMB GUID from the 10 random bytes and time with millisecond precision
+ */
+
+func NewComb(random []byte, t time.Time) UUID {
+
+	var data [16]byte
+	copy(data[:10], random)
+	binary.BigEndian.PutUint16(data[10:], uint16(t.UnixMilli() >> 32))
+	binary.BigEndian.PutUint32(data[12:], uint32(t.UnixMilli()))
+
+	data[6]  &= 0x0f;  /* clear version        */
+	data[6]  |= 0x40;  /* set to version 4     */
+	data[8]  &= 0x3f;  /* clear variant        */
+	data[8]  |= 0x80;  /* set to IETF variant  */
+
+	return FromArray(data)
+}
+
+/**
+	Gets time stored in the last 6 bytes of COMB GUID
+ */
+
+func (this UUID) CombTime() time.Time {
+	return time.UnixMilli(int64(this.leastSigBits) & nodeMask)
+}
@@ -0,0 +1,62 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComb(t *testing.T) {
+
+	now := time.UnixMilli(1700000000123)
+
+	uuid := NewComb(bytes.Repeat([]byte{ 0xFF }, 10), now)
+	assert.Equal(t, "ffffffff-ffff-4fff-bfff-018bcfe5687b", uuid.String())
+	assert.Equal(t, RandomlyGeneratedVer4, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, now, uuid.CombTime())
+
+	// time never goes backwards
+
+	gen := NewCombGenerator()
+	clock := now
+	gen.clock = func() time.Time { return clock }
+
+	first, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, now, first.CombTime())
+
+	clock = now.Add(-time.Second)
+	second, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, now, second.CombTime())
+	assert.False(t, first.Equal(second))
+
+	clock = now.Add(time.Millisecond)
+	third, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(time.Millisecond), third.CombTime())
+
+	var _ Generator = gen
+
+}
@@ -0,0 +1,80 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+)
+
+/**
+	Stores UUID in to 32 hex characters without dashes
+ */
+
+func (this UUID) MarshalCompactText() ([]byte, error) {
+	return this.AppendCompactText(make([]byte, 0, 32)), nil
+}
+
+/**
+	Appends 32 hex characters of UUID without dashes to the slice
+ */
+
+func (this UUID) AppendCompactText(dst []byte) []byte {
+	data := this.Array()
+	n := len(dst)
+	dst = append(dst, make([]byte, 32)...)
+	encodeHex(dst[n:], data[:])
+	return dst
+}
+
+/**
+	Gets 32 hex characters of UUID without dashes
+ */
+
+func (this UUID) CompactString() string {
+	var buf [32]byte
+	return string(this.AppendCompactText(buf[:0]))
+}
+
+/**
+	Parses 32 hex characters of UUID without dashes
+
+	Unlike Parse accepts only the compact form
+ */
+
+func ParseCompact(s string) (UUID, error) {
+	return ParseCompactBytes([]byte(s))
+}
+
+/**
+	Parses bytes of 32 hex characters of UUID without dashes
+ */
+
+func ParseCompactBytes(src []byte) (UUID, error) {
+
+	if len(src) != 32 {
+		return Empty, ErrorWrongLen
+	}
+
+	var data [16]byte
+	if pos := decodeHex(data[:], src); pos >= 0 {
+		return Empty, invalidFormat(src, pos, fmt.Sprintf("invalid hex character %q", src[pos]))
+	}
+
+	return FromArray(data), nil
+}
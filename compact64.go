@@ -0,0 +1,63 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	compact64EntropyBits = 20
+	maxCompact64Millis = int64(1) << 43 - 1
+)
+
+var (
+	ErrorCompact64TimeOverflow = errors.New("time is out of compact 64-bit ID range")
+)
+
+/**
+	Derives lossy time-ordered positive 64-bit integer from Time-based UUID (versions 1, 6 and 7)
+
+	Used as the key in legacy schemas accepting only BIGINT: zero sign bit + 43-bit unix time in milliseconds
+	+ top 20 bits of MurmurHash3 of the whole UUID, so IDs are ordered by milliseconds and derivation is deterministic
+
+	Time range is from the unix epoch to the year 2248, returns ErrorCompact64TimeOverflow out of the range
+	and ErrorRequiredTimebasedUUID for other versions
+
+	Collision analysis: UUIDs of different milliseconds never collide, n distinct UUIDs of the same millisecond
+	fall in to 2^20 values and collide with the probability about 1 - exp(-n^2 / 2^21): 1% for 145 UUIDs
+	and 50% for 1206 UUIDs in the same millisecond; generation rate of the IDs kept as unique keys
+	should be well below a hundred per millisecond
+ */
+
+func (this UUID) Compact64() (int64, error) {
+
+	time100Nanos, ok := this.embeddedUnixTime100Nanos()
+	if !ok {
+		return 0, ErrorRequiredTimebasedUUID
+	}
+
+	millis := time100Nanos / one100NanosInMillis
+	if time100Nanos < 0 || millis > maxCompact64Millis {
+		return 0, ErrorCompact64TimeOverflow
+	}
+
+	entropy := murmur3H1(this.mostSigBits, this.leastSigBits) >> (64 - compact64EntropyBits)
+	return millis << compact64EntropyBits | int64(entropy), nil
+}
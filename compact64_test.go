@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact64(t *testing.T) {
+
+	at := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	for _, version := range []Version{ TimebasedVer1, ReorderedTimebasedVer6, UnixTimebasedVer7 } {
+		uuid, err := MinUUIDForTime(at, version)
+		assert.NoError(t, err)
+		uuid.leastSigBits ^= 0x123456789
+
+		id, err := uuid.Compact64()
+		assert.NoError(t, err)
+		assert.True(t, id > 0)
+		assert.Equal(t, at.UnixMilli(), id >> 20)
+
+		again, _ := uuid.Compact64()
+		assert.Equal(t, id, again)
+	}
+
+	// ordered by milliseconds
+
+	prev := int64(0)
+	for i := 0; i != 1000; i = i + 1 {
+		id, err := UUIDAt(at.Add(time.Duration(i) * time.Millisecond)).Compact64()
+		assert.NoError(t, err)
+		assert.True(t, prev < id)
+		prev = id
+	}
+
+	// entropy differs within the same millisecond
+
+	seen := make(map[int64]bool)
+	for i := 0; i != 100; i = i + 1 {
+		id, _ := UUIDAt(at).Compact64()
+		seen[id] = true
+	}
+	assert.True(t, len(seen) > 90)
+
+	// errors
+
+	_, err := NewUUID(RandomlyGeneratedVer4).Compact64()
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	_, err = UUIDAt(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)).Compact64()
+	assert.Equal(t, ErrorCompact64TimeOverflow, err)
+
+	_, err = UUIDAt(time.Date(2249, 1, 1, 0, 0, 0, 0, time.UTC)).Compact64()
+	assert.Equal(t, ErrorCompact64TimeOverflow, err)
+
+	_, err = UUIDAt(time.Date(2248, 1, 1, 0, 0, 0, 0, time.UTC)).Compact64()
+	assert.NoError(t, err)
+
+}
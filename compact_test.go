@@ -0,0 +1,65 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	data, err := uuid.MarshalCompactText()
+	assert.NoError(t, err)
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", string(data))
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", uuid.CompactString())
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "id="...)
+	buf = uuid.AppendCompactText(buf)
+	assert.Equal(t, "id=534b44a19bf13d20b71ecc4eb77c572f", string(buf))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = uuid.AppendCompactText(buf[:0])
+	})
+	assert.Equal(t, float64(0), allocs)
+
+	actual, err := ParseCompact("534b44a19bf13d20b71ecc4eb77c572f")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	actual, err = ParseCompact("534B44A19BF13D20B71ECC4EB77C572F")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	_, err = ParseCompact("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = ParseCompact("534b44a19bf13d20b71ecc4eb77c572z")
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+
+}
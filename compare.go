@@ -0,0 +1,165 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+/**
+	Compares UUIDs in the lexicographic order of canonical 16 bytes (MarshalBinary output)
+
+	The same order as bytes.Compare of MarshalBinary outputs, ComparePostgres and string comparison
+	of the canonical text, ready for slices.SortFunc(uuids, UUID.Compare)
+
+	return -1 if this < other, 0 if this == other, +1 if this > other
+ */
+
+func (this UUID) Compare(other UUID) int {
+	return ComparePostgres(this, other)
+}
+
+/**
+	Checks if UUID is less than the other one in the Compare order
+ */
+
+func (this UUID) Less(other UUID) bool {
+	return this.Compare(other) < 0
+}
+
+/**
+	Compares UUIDs by the embedded timestamp and then in the Compare order
+
+	Time-based versions 1, 6 and 7 are compared by unix time in 100 nanoseconds (milliseconds for v7)
+	regardless of the version, UUIDs without the embedded timestamp are ordered after time-based ones
+
+	return -1 if this < other, 0 if this == other, +1 if this > other
+ */
+
+func (this UUID) CompareByTime(other UUID) int {
+
+	thisTime, thisOk := this.embeddedUnixTime100Nanos()
+	otherTime, otherOk := other.embeddedUnixTime100Nanos()
+
+	switch {
+	case thisOk && !otherOk:
+		return -1
+	case !thisOk && otherOk:
+		return 1
+	case thisOk && thisTime < otherTime:
+		return -1
+	case thisOk && thisTime > otherTime:
+		return 1
+	default:
+		return this.Compare(other)
+	}
+}
+
+/**
+	Checks if UUID is less than the other one in the CompareByTime order
+ */
+
+func (this UUID) LessByTime(other UUID) bool {
+	return this.CompareByTime(other) < 0
+}
+
+/**
+	Gets embedded timestamp of time-based versions in 100 nanoseconds since unix epoch
+ */
+
+func (this UUID) embeddedUnixTime100Nanos() (int64, bool) {
+	switch this.Version() {
+	case TimebasedVer1:
+		return this.UnixTime100Nanos(), true
+	case ReorderedTimebasedVer6:
+		return int64(this.mostSigBits >> 16 << 12 | this.mostSigBits & 0xFFF) - num100NanosSinceUUIDEpoch, true
+	case UnixTimebasedVer7:
+		return int64(this.mostSigBits >> 16) * one100NanosInMillis, true
+	default:
+		return 0, false
+	}
+}
+
+/**
+	Flags of Between predicate, zero value checks lo <= uuid <= hi in the Compare order
+ */
+
+type BetweenMode int
+
+const (
+	Inclusive   BetweenMode = 0
+
+	/**
+		Excludes lo bound
+	 */
+
+	ExclusiveLo BetweenMode = 1
+
+	/**
+		Excludes hi bound, ExclusiveHi is the half-open range [lo, hi)
+	 */
+
+	ExclusiveHi BetweenMode = 2
+
+	/**
+		Compares only embedded timestamps, UUIDs without the embedded timestamp are never between
+	 */
+
+	ByTime BetweenMode = 4
+
+	Exclusive = ExclusiveLo | ExclusiveHi
+)
+
+/**
+	Checks if UUID is between lo and hi bounds
+
+	for _, e := range events { if e.ID.Between(lo, hi, timeuuid.ExclusiveHi | timeuuid.ByTime) { ... } }
+ */
+
+func (this UUID) Between(lo, hi UUID, mode BetweenMode) bool {
+
+	var loCmp, hiCmp int
+
+	if mode & ByTime != 0 {
+		thisTime, thisOk := this.embeddedUnixTime100Nanos()
+		loTime, loOk := lo.embeddedUnixTime100Nanos()
+		hiTime, hiOk := hi.embeddedUnixTime100Nanos()
+		if !thisOk || !loOk || !hiOk {
+			return false
+		}
+		loCmp, hiCmp = compareInt64(loTime, thisTime), compareInt64(thisTime, hiTime)
+	} else {
+		loCmp, hiCmp = lo.Compare(this), this.Compare(hi)
+	}
+
+	if loCmp > 0 || loCmp == 0 && mode & ExclusiveLo != 0 {
+		return false
+	}
+	if hiCmp > 0 || hiCmp == 0 && mode & ExclusiveHi != 0 {
+		return false
+	}
+	return true
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+
+	uuids := make([]UUID, 100)
+	for i := range uuids {
+		uuids[i] = CreateUUID(rand.Int63() - rand.Int63(), rand.Int63() - rand.Int63())
+	}
+	uuids[1] = uuids[0]
+
+	for _, a := range uuids {
+		for _, b := range uuids {
+			aData, _ := a.MarshalBinary()
+			bData, _ := b.MarshalBinary()
+			assert.Equal(t, bytes.Compare(aData, bData), a.Compare(b))
+			assert.Equal(t, a.String() < b.String(), a.Less(b))
+		}
+	}
+
+	slices.SortFunc(uuids, UUID.Compare)
+	assert.True(t, slices.IsSortedFunc(uuids, func(a, b UUID) int {
+		return bytes.Compare([]byte(a.String()), []byte(b.String()))
+	}))
+
+}
+
+func TestCompareByTime(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	v1.SetCounter(0x3fffffffffffffff)
+
+	v6, _ := MinUUIDForTime(now.Add(time.Microsecond), ReorderedTimebasedVer6)
+	v7, _ := MaxUUIDForTime(now.Add(time.Millisecond), UnixTimebasedVer7)
+	v4 := NewUUID(RandomlyGeneratedVer4)
+
+	later := NewUUID(TimebasedVer1)
+	later.SetTime(now.Add(time.Second))
+
+	expected := []UUID{ v1, v6, v7, later, v4 }
+	actual := []UUID{ v4, later, v7, v6, v1 }
+	slices.SortFunc(actual, UUID.CompareByTime)
+	assert.Equal(t, expected, actual)
+
+	assert.True(t, v1.LessByTime(v6))
+	assert.False(t, v6.LessByTime(v1))
+	assert.True(t, later.LessByTime(v4))
+
+	// same time falls back to bytes
+
+	other := v1
+	other.SetCounter(0)
+	assert.Equal(t, other.Compare(v1), other.CompareByTime(v1))
+	assert.Equal(t, 0, v1.CompareByTime(v1))
+
+}
+
+func TestBetween(t *testing.T) {
+
+	lo, mid, hi := CreateUUID(0, 1), CreateUUID(0, 2), CreateUUID(0, 3)
+
+	assert.True(t, mid.Between(lo, hi, Inclusive))
+	assert.True(t, lo.Between(lo, hi, Inclusive))
+	assert.True(t, hi.Between(lo, hi, Inclusive))
+	assert.False(t, lo.Between(lo, hi, ExclusiveLo))
+	assert.True(t, hi.Between(lo, hi, ExclusiveLo))
+	assert.True(t, lo.Between(lo, hi, ExclusiveHi))
+	assert.False(t, hi.Between(lo, hi, ExclusiveHi))
+	assert.False(t, lo.Between(lo, hi, Exclusive))
+	assert.False(t, hi.Between(lo, hi, Exclusive))
+	assert.True(t, mid.Between(lo, hi, Exclusive))
+	assert.False(t, CreateUUID(0, 4).Between(lo, hi, Inclusive))
+	assert.False(t, Empty.Between(lo, hi, Inclusive))
+
+	// by time
+
+	now := time.Now()
+	start, _ := MinUUIDForTime(now, TimebasedVer1)
+	end, _ := MinUUIDForTime(now.Add(time.Hour), UnixTimebasedVer7)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(now)
+	uuid.SetCounter(rand.Int63())
+
+	assert.True(t, uuid.Between(start, end, ByTime))
+	assert.True(t, uuid.Between(start, end, ByTime | ExclusiveHi))
+	assert.False(t, uuid.Between(start, end, ByTime | ExclusiveLo))
+	assert.False(t, uuid.AddDuration(2 * time.Hour).Between(start, end, ByTime))
+	assert.False(t, NewUUID(RandomlyGeneratedVer4).Between(start, end, ByTime))
+
+}
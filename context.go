@@ -0,0 +1,56 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"context"
+)
+
+type generatorContextKey struct{}
+
+/**
+	Returns copy of the context carrying the generator
+
+	Used by frameworks to install a tenant- or test-specific generator picked up by FromContext
+ */
+
+func NewContext(ctx context.Context, gen Generator) context.Context {
+	return context.WithValue(ctx, generatorContextKey{}, gen)
+}
+
+/**
+	Gets generator installed by NewContext, returns false if the context carries no generator
+ */
+
+func FromContext(ctx context.Context) (Generator, bool) {
+	gen, ok := ctx.Value(generatorContextKey{}).(Generator)
+	return gen, ok
+}
+
+/**
+	Issues next UUID by the generator of the context, or by the package default generator of Next
+	if the context carries no generator
+ */
+
+func NextContext(ctx context.Context) (UUID, error) {
+	if gen, ok := FromContext(ctx); ok {
+		return gen.Next()
+	}
+	return Next()
+}
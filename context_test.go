@@ -0,0 +1,54 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+
+	ctx := context.Background()
+
+	_, ok := FromContext(ctx)
+	assert.False(t, ok)
+
+	uuid, err := NextContext(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+
+	gen := NewFixtureGenerator(time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC))
+	ctx = NewContext(ctx, gen)
+
+	actual, ok := FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, Generator(gen), actual)
+
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	uuid, err = NextContext(child)
+	assert.NoError(t, err)
+	assert.Equal(t, "968b8086-a91b-11ee-8080-808080808080", uuid.String())
+
+}
@@ -0,0 +1,119 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"math/bits"
+)
+
+var (
+	ErrorNoFreeSlot = errors.New("all clock sequence slots are claimed")
+)
+
+/**
+	Exclusive lock shared by processes of the same host, like file lock of NewFileLocker
+
+	TryLock returns false without waiting if the lock is held by another owner
+ */
+
+type Locker interface {
+	TryLock() (bool, error)
+	Unlock() error
+}
+
+/**
+	Slot of clock sequence values claimed by the process
+
+	Processes sharing the same node, like sidecar and main process, claim different slots of the same lockers,
+	slot index is stored in the high bits of clock sequence and generators of the slot increment only the low bits,
+	so the processes never issue duplicate Time-based UUIDs (version 1)
+ */
+
+type ClockSequenceSlot struct {
+	locker Locker
+	slot   int
+	mask   int
+}
+
+/**
+	Claims the first free slot, one locker per slot
+
+	Number of slots is rounded up to the power of two of the high clock sequence bits, at most 8192 slots are supported;
+	returns ErrorNoFreeSlot if all lockers are held by other owners
+ */
+
+func ClaimClockSequenceSlot(lockers []Locker) (*ClockSequenceSlot, error) {
+
+	if len(lockers) == 0 || len(lockers) > (clockSequenceBits + 1) / 2 {
+		return nil, errors.Errorf("unsupported number of clock sequence slots %d", len(lockers))
+	}
+
+	slotBits := bits.Len(uint(len(lockers) - 1))
+	shift := bits.Len(uint(clockSequenceBits)) - slotBits
+
+	for i, locker := range lockers {
+		ok, err := locker.TryLock()
+		if err != nil {
+			return nil, errors.Wrapf(err, "slot %d", i)
+		}
+		if ok {
+			return &ClockSequenceSlot{
+				locker: locker,
+				slot:   i << shift,
+				mask:   clockSequenceBits &^ (1 << shift - 1),
+			}, nil
+		}
+	}
+
+	return nil, ErrorNoFreeSlot
+}
+
+/**
+	Gets clock sequence bits of the slot
+ */
+
+func (this *ClockSequenceSlot) Slot() int {
+	return this.slot
+}
+
+/**
+	Creates generator for the node with random initial clock sequence within the slot
+ */
+
+func (this *ClockSequenceSlot) Generator(node int64) (*TimebasedGenerator, error) {
+	var randomBytes [2]byte
+	if _, err := rand.Read(randomBytes[:]); err != nil {
+		return nil, err
+	}
+	clockSequence := int(binary.BigEndian.Uint16(randomBytes[:])) &^ this.mask | this.slot
+	gen := NewTimebasedGenerator(node, clockSequence)
+	gen.slotMask = this.mask
+	return gen, nil
+}
+
+/**
+	Releases the slot, generators of the slot must not be used after release
+ */
+
+func (this *ClockSequenceSlot) Release() error {
+	return this.locker.Unlock()
+}
@@ -0,0 +1,118 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryLocker struct {
+	locked bool
+}
+
+func (this *memoryLocker) TryLock() (bool, error) {
+	if this.locked {
+		return false, nil
+	}
+	this.locked = true
+	return true, nil
+}
+
+func (this *memoryLocker) Unlock() error {
+	this.locked = false
+	return nil
+}
+
+func TestClockSequenceSlot(t *testing.T) {
+
+	lockers := []Locker{ &memoryLocker{}, &memoryLocker{}, &memoryLocker{} }
+
+	first, err := ClaimClockSequenceSlot(lockers)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, first.Slot())
+
+	second, err := ClaimClockSequenceSlot(lockers)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x1000, second.Slot())
+
+	third, err := ClaimClockSequenceSlot(lockers)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x2000, third.Slot())
+
+	_, err = ClaimClockSequenceSlot(lockers)
+	assert.Equal(t, ErrorNoFreeSlot, err)
+
+	// generators of different slots never share clock sequence on the same node and time
+
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	firstGen, err := first.Generator(0x123456789ABC)
+	assert.NoError(t, err)
+	firstGen.clock = clock
+	firstGen.SetOverflowPolicy(OverflowError)
+
+	secondGen, err := second.Generator(0x123456789ABC)
+	assert.NoError(t, err)
+	secondGen.clock = clock
+
+	seen := make(map[UUID]bool)
+	for i := 0; i != 0x1000; i = i + 1 {
+		uuid, err := firstGen.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, uuid.ClockSequence() & 0x3000)
+		seen[uuid] = true
+
+		uuid, err = secondGen.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 0x1000, uuid.ClockSequence() & 0x3000)
+		seen[uuid] = true
+	}
+	assert.Equal(t, 0x2000, len(seen))
+
+	// slot is exhausted within the same tick
+
+	_, err = firstGen.Next()
+	assert.Equal(t, ErrorSequenceOverflow, err)
+
+	// clock regression keeps the slot
+
+	now = now.Add(-time.Second)
+	uuid, err := secondGen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, 0x1000, uuid.ClockSequence() & 0x3000)
+
+	// released slot is claimed again
+
+	assert.NoError(t, second.Release())
+	again, err := ClaimClockSequenceSlot(lockers)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x1000, again.Slot())
+
+	single, err := ClaimClockSequenceSlot([]Locker{ &memoryLocker{} })
+	assert.NoError(t, err)
+	assert.Equal(t, 0, single.Slot())
+
+	_, err = ClaimClockSequenceSlot(nil)
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,108 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+/**
+	Local domain of DCE Security UUID (version 2)
+ */
+
+type Domain byte
+
+// Constants returned by Domain.
+const (
+	Person = Domain(0)
+	Group  = Domain(1)
+	Org    = Domain(2)
+)
+
+const (
+	dceClockSequenceBits = 0x3F
+)
+
+/**
+	Creates DCE Security UUID (version 2) for the local domain and identifier at the current time
+
+	Clock sequence and node are random, node has the multicast bit set
+ */
+
+func NewDCESecurity(domain Domain, id uint32) (UUID, error) {
+
+	var randomBytes [8]byte
+	if err := readRandom(randomBytes[:]); err != nil {
+		return Empty, err
+	}
+
+	node := int64(binary.BigEndian.Uint64(randomBytes[:])) & nodeMask | multicastNodeBit
+	return CreateDCESecurity(domain, id, time.Now(), int(randomBytes[0]), node), nil
+}
+
+/**
+	Creates DCE Security UUID (version 2) of DCE 1.1 Authentication and Security Services
+
+	time_low holds the identifier (POSIX UID or GID), clock_seq_low holds the domain,
+	only 6 bits of the clock sequence are kept and the time loses 32 low bits
+ */
+
+func CreateDCESecurity(domain Domain, id uint32, t time.Time, clockSequence int, node int64) UUID {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(t)
+	uuid.SetNode(node)
+	uuid.mostSigBits = uint64(id) << 32 | uuid.mostSigBits & 0xFFFF0FFF | uint64(DCESecurityVer2) << 12
+	uuid.leastSigBits = uuid.leastSigBits & 0xC000FFFFFFFFFFFF | uint64(clockSequence & dceClockSequenceBits) << 56 | uint64(domain) << 48
+	return uuid
+}
+
+/**
+	Gets local domain of DCE Security UUID (version 2)
+ */
+
+func (this UUID) Domain() Domain {
+	return Domain(this.leastSigBits >> 48)
+}
+
+/**
+	Gets local identifier of DCE Security UUID (version 2), POSIX UID for Person and GID for Group domains
+ */
+
+func (this UUID) ID() uint32 {
+	return uint32(this.mostSigBits >> 32)
+}
+
+/**
+	Gets string representation of the domain
+ */
+
+func (d Domain) String() string {
+	switch d {
+	case Person:
+		return "Person"
+	case Group:
+		return "Group"
+	case Org:
+		return "Org"
+	default:
+		return fmt.Sprintf("Domain%d", int(d))
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCESecurity(t *testing.T) {
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	uuid := CreateDCESecurity(Group, 1000, now, 0x15, 0x123456789ABC)
+	assert.Equal(t, "000003e8-a91b-21ee-9501-123456789abc", uuid.String())
+	assert.Equal(t, DCESecurityVer2, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, Group, uuid.Domain())
+	assert.Equal(t, uint32(1000), uuid.ID())
+	assert.Equal(t, int64(0x123456789ABC), uuid.Node())
+	assert.NoError(t, uuid.Validate())
+
+	// time_mid and time_hi are kept
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	assert.Equal(t, v1.mostSigBits & 0xFFFF0FFF, uuid.mostSigBits & 0xFFFF0FFF)
+
+	uuid, err := NewDCESecurity(Person, 501)
+	assert.NoError(t, err)
+	assert.Equal(t, DCESecurityVer2, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, Person, uuid.Domain())
+	assert.Equal(t, uint32(501), uuid.ID())
+	assert.Equal(t, int64(0x010000000000), uuid.Node() & multicastNodeBit)
+
+	assert.Equal(t, "Person", Person.String())
+	assert.Equal(t, "Org", Org.String())
+	assert.Equal(t, "Domain7", Domain(7).String())
+
+}
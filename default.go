@@ -0,0 +1,86 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"sync"
+)
+
+var (
+
+	/**
+		Package default Time-based UUID (version 1) generator with random node and clock sequence,
+		created on the first successful call of Next, guarded by defaultMutex
+	 */
+
+	defaultMutex     sync.Mutex
+	defaultGenerator Generator
+
+	/**
+		Package default Unix Time-based UUID (version 7) generator created on the first call of NextV7
+	 */
+
+	defaultV7Generator = sync.OnceValue(func() Generator {
+		return NewUnixTimebasedGenerator()
+	})
+)
+
+/**
+	Returns the package default Time-based UUID (version 1) generator
+
+	Failure to read the random node and clock sequence is not cached, the next call tries again
+ */
+
+func getDefaultGenerator() (Generator, error) {
+	defaultMutex.Lock()
+	defer defaultMutex.Unlock()
+	if defaultGenerator == nil {
+		gen, err := NewGenerator(WithRand(randomReader))
+		if err != nil {
+			return nil, err
+		}
+		defaultGenerator = gen
+	}
+	return defaultGenerator, nil
+}
+
+/**
+	Issues next Time-based UUID (version 1) by the package default generator
+
+	Node is random with the multicast bit set, so it never collides with
+	a real MAC address; safe for concurrent use
+ */
+
+func Next() (UUID, error) {
+	gen, err := getDefaultGenerator()
+	if err != nil {
+		return Empty, err
+	}
+	return gen.Next()
+}
+
+/**
+	Issues next Unix Time-based UUID (version 7) by the package default generator
+
+	Safe for concurrent use
+ */
+
+func NextV7() (UUID, error) {
+	return defaultV7Generator().Next()
+}
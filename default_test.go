@@ -0,0 +1,83 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultGenerator(t *testing.T) {
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	seen := make(map[UUID]bool)
+
+	for i := 0; i != 8; i = i + 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 1000; j = j + 1 {
+				uuid, err := Next()
+				assert.NoError(t, err)
+				uuidV7, err := NextV7()
+				assert.NoError(t, err)
+				mutex.Lock()
+				seen[uuid] = true
+				seen[uuidV7] = true
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 16000, len(seen))
+
+	uuid, _ := Next()
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.NotEqual(t, int64(0), uuid.Node() & 0x010000000000)
+
+	uuid, _ = NextV7()
+	assert.Equal(t, UnixTimebasedVer7, uuid.Version())
+
+}
+
+func TestDefaultGeneratorRetry(t *testing.T) {
+
+	defer func(reader io.Reader, gen Generator) {
+		randomReader = reader
+		defaultGenerator = gen
+	}(randomReader, defaultGenerator)
+
+	defaultGenerator = nil
+	randomReader = &failingReader{ failures: 1, reader: rand.Reader }
+
+	_, err := Next()
+	assert.True(t, errors.Is(err, errEntropy))
+
+	uuid, err := Next()
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+
+}
@@ -0,0 +1,133 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+)
+
+/**
+	Templates of .NET Guid format specifiers, '_' is position of the next hex digit
+ */
+
+var dotNetTemplates = map[byte]string {
+	'N': "________________________________",
+	'D': "________-____-____-____-____________",
+	'B': "{________-____-____-____-____________}",
+	'P': "(________-____-____-____-____________)",
+	'X': "{0x________,0x____,0x____,{0x__,0x__,0x__,0x__,0x__,0x__,0x__,0x__}}",
+}
+
+var (
+	ErrorUnknownDotNetSpec = errors.New("unknown .NET Guid format specifier")
+)
+
+func dotNetTemplate(spec byte) (string, error) {
+	if 'a' <= spec && spec <= 'z' {
+		spec = spec - 'a' + 'A'
+	}
+	template, ok := dotNetTemplates[spec]
+	if !ok {
+		return "", errors.Wrapf(ErrorUnknownDotNetSpec, "%q", spec)
+	}
+	return template, nil
+}
+
+/**
+	Formats UUID with .NET Guid format specifier, the same way as Guid.ToString(spec) in C#
+
+	N  32 digits                              00000000000000000000000000000000
+	D  32 digits separated by hyphens         00000000-0000-0000-0000-000000000000
+	B  D enclosed in braces                   {00000000-0000-0000-0000-000000000000}
+	P  D enclosed in parentheses              (00000000-0000-0000-0000-000000000000)
+	X  hexadecimal values enclosed in braces  {0x00000000,0x0000,0x0000,{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00}}
+
+	Specifier is case-insensitive and digits are in lower case like in .NET
+ */
+
+func (this UUID) FormatDotNet(spec byte) (string, error) {
+
+	template, err := dotNetTemplate(spec)
+	if err != nil {
+		return "", err
+	}
+
+	data := this.Array()
+	dst := []byte(template)
+	digit := 0
+	for i, c := range dst {
+		if c == '_' {
+			b := data[digit / 2]
+			if digit % 2 == 0 {
+				b >>= 4
+			}
+			dst[i] = hexDigits[b & 0x0F]
+			digit++
+		}
+	}
+
+	return string(dst), nil
+}
+
+/**
+	Parses UUID formatted with .NET Guid format specifier, the same way as Guid.ParseExact(s, spec) in C#
+
+	Hex digits and the 0x prefixes are case-insensitive
+ */
+
+func ParseDotNet(s string, spec byte) (UUID, error) {
+
+	template, err := dotNetTemplate(spec)
+	if err != nil {
+		return Empty, err
+	}
+
+	if len(s) != len(template) {
+		return Empty, ErrorWrongLen
+	}
+
+	var data [16]byte
+	digit := 0
+	for i := 0; i < len(s); i++ {
+
+		c := s[i]
+		t := template[i]
+
+		if t != '_' {
+			if c != t && !(t == 'x' && c == 'X') {
+				return Empty, errors.Errorf("invalid .NET Guid format %q at position %d: %q", spec, i, s)
+			}
+			continue
+		}
+
+		v := hexDecoding[c]
+		if v == 0xFF {
+			return Empty, errors.Errorf("invalid hex character %q at position %d", c, i)
+		}
+
+		if digit % 2 == 0 {
+			data[digit / 2] = v << 4
+		} else {
+			data[digit / 2] |= v
+		}
+		digit++
+	}
+
+	return FromArray(data), nil
+}
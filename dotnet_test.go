@@ -0,0 +1,76 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDotNet(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	expected := map[byte]string {
+		'N': "534b44a19bf13d20b71ecc4eb77c572f",
+		'D': "534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		'B': "{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}",
+		'P': "(534b44a1-9bf1-3d20-b71e-cc4eb77c572f)",
+		'X': "{0x534b44a1,0x9bf1,0x3d20,{0xb7,0x1e,0xcc,0x4e,0xb7,0x7c,0x57,0x2f}}",
+	}
+
+	for spec, text := range expected {
+
+		for _, s := range []byte { spec, spec - 'A' + 'a' } {
+
+			formatted, err := uuid.FormatDotNet(s)
+			assert.NoError(t, err)
+			assert.Equal(t, text, formatted)
+
+			actual, err := ParseDotNet(text, s)
+			assert.NoError(t, err)
+			assert.True(t, uuid.Equal(actual), string(s))
+		}
+
+		_, err = ParseDotNet(text[1:], spec)
+		assert.Equal(t, ErrorWrongLen, err)
+	}
+
+	actual, err := ParseDotNet("{0X534B44A1,0X9BF1,0X3D20,{0XB7,0X1E,0XCC,0X4E,0XB7,0X7C,0X57,0X2F}}", 'X')
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	_, err = ParseDotNet("{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}", 'P')
+	assert.Error(t, err)
+
+	_, err = ParseDotNet("534b44a1-9bf1-3d20-b71e-cc4eb77c572g", 'D')
+	assert.Error(t, err)
+
+	_, err = uuid.FormatDotNet('Q')
+	assert.True(t, errors.Is(err, ErrorUnknownDotNetSpec))
+
+	_, err = ParseDotNet("", 'Q')
+	assert.True(t, errors.Is(err, ErrorUnknownDotNetSpec))
+
+}
@@ -0,0 +1,134 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrorUnixTimeOverflow = errors.New("time is out of unix time range")
+)
+
+/**
+	Issues pairs of UUIDs in the existing and in the new scheme for phased migrations
+
+	Existing-scheme UUID is issued by Legacy generator, new-scheme UUID is derived from it by Mapping,
+	so the same legacy UUID always maps to the same new UUID; the pair is reported to OnIssue
+	to be recorded while both keys coexist
+ */
+
+type DualIssuer struct {
+
+	/**
+		Generator of the existing-scheme UUIDs, e.g. TimebasedGenerator
+	 */
+
+	Legacy Generator
+
+	/**
+		Deterministic mapping to the new-scheme UUID, DeriveUnixTimebased if nil
+	 */
+
+	Mapping func(legacy UUID) (UUID, error)
+
+	/**
+		Optional callback to record mapping, pair is not returned if it fails
+	 */
+
+	OnIssue func(legacy, next UUID) error
+}
+
+/**
+	Creates issuer of version 1 and version 7 pairs
+ */
+
+func NewDualIssuer(legacy Generator, onIssue func(legacy, next UUID) error) *DualIssuer {
+	return &DualIssuer{
+		Legacy:  legacy,
+		Mapping: DeriveUnixTimebased,
+		OnIssue: onIssue,
+	}
+}
+
+/**
+	Issues the existing-scheme UUID and the new-scheme UUID mapped from it
+ */
+
+func (this *DualIssuer) Issue() (legacy UUID, next UUID, err error) {
+
+	legacy, err = this.Legacy.Next()
+	if err != nil {
+		return Empty, Empty, err
+	}
+
+	mapping := this.Mapping
+	if mapping == nil {
+		mapping = DeriveUnixTimebased
+	}
+
+	next, err = mapping(legacy)
+	if err != nil {
+		return Empty, Empty, err
+	}
+
+	if this.OnIssue != nil {
+		if err = this.OnIssue(legacy, next); err != nil {
+			return Empty, Empty, err
+		}
+	}
+
+	return legacy, next, nil
+}
+
+/**
+	Derives unix time-based UUID (version 7) from the time-based UUID (version 1)
+
+	Unix time in milliseconds and the 12-bit sub-millisecond fraction are taken from the timestamp,
+	62 bits of rand_b are taken from SHA-1 digest of the source, so the mapping is deterministic
+	and keeps time ordering up to the fraction precision
+
+	Version 7 could not hold time before unix epoch, returns ErrorUnixTimeOverflow for such UUIDs
+ */
+
+func DeriveUnixTimebased(uuid UUID) (UUID, error) {
+
+	if uuid.Version() != TimebasedVer1 {
+		return Empty, ErrorRequiredTimebasedUUID
+	}
+
+	var data [16]byte
+	uuid.MarshalBinaryTo(data[:])
+	digest := sha1.Sum(data[:])
+
+	unixTime100Nanos := uuid.UnixTime100Nanos()
+	if unixTime100Nanos < 0 {
+		return Empty, ErrorUnixTimeOverflow
+	}
+	millis := unixTime100Nanos / one100NanosInMillis
+	subMillis := unixTime100Nanos % one100NanosInMillis
+	fraction := uint64(subMillis) * (subMillisFractionBits + 1) / uint64(one100NanosInMillis)
+
+	var derived UUID
+	derived.mostSigBits = uint64(millis) << 16 | unixTimebasedVersionBits | fraction
+	derived.leastSigBits = binary.BigEndian.Uint64(digest[:8]) & counterMask | variantIETFBits
+	return derived, nil
+}
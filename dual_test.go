@@ -0,0 +1,95 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDualIssuer(t *testing.T) {
+
+	mapping := make(map[UUID]UUID)
+
+	issuer := NewDualIssuer(NewTimebasedGenerator(0x123456, 7), func(legacy, next UUID) error {
+		mapping[legacy] = next
+		return nil
+	})
+
+	var prev UUID
+	for i := 0; i != 100; i = i + 1 {
+
+		legacy, next, err := issuer.Issue()
+		if err != nil {
+			t.Fatal("fail to issue ", err)
+		}
+
+		assert.Equal(t, TimebasedVer1, legacy.Version())
+		assert.Equal(t, UnixTimebasedVer7, next.Version())
+		assert.Equal(t, IETF, next.Variant())
+		assert.Equal(t, next, mapping[legacy])
+		assert.Equal(t, uint64(legacy.UnixTimeMillis()), next.mostSigBits >> 16)
+
+		again, err := DeriveUnixTimebased(legacy)
+		assert.NoError(t, err)
+		assert.True(t, next.Equal(again))
+
+		if i > 0 {
+			assert.True(t, prev.mostSigBits <= next.mostSigBits)
+		}
+		prev = next
+	}
+	assert.Equal(t, 100, len(mapping))
+
+	// time before unix epoch
+
+	legacy := NewUUID(TimebasedVer1)
+	legacy.SetTime(time.Date(1960, 5, 6, 7, 8, 9, 0, time.UTC))
+	_, err := DeriveUnixTimebased(legacy)
+	assert.Equal(t, ErrorUnixTimeOverflow, err)
+
+	legacy.SetTime(time.Unix(-1, 999999900))
+	_, err = DeriveUnixTimebased(legacy)
+	assert.Equal(t, ErrorUnixTimeOverflow, err)
+
+	legacy.SetTime(time.Unix(0, 500000))
+	next, err := DeriveUnixTimebased(legacy)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), next.mostSigBits >> 16)
+	assert.Equal(t, uint64(0x7000 | 2048), next.mostSigBits & 0xFFFF)
+
+	_, err = DeriveUnixTimebased(NewUUID(RandomlyGeneratedVer4))
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	errRecord := errors.New("record failed")
+	issuer.OnIssue = func(legacy, next UUID) error {
+		return errRecord
+	}
+	_, _, err = issuer.Issue()
+	assert.Equal(t, errRecord, err)
+
+	issuer = &DualIssuer{Legacy: NewTimebasedGenerator(0, 0)}
+	_, next, err = issuer.Issue()
+	assert.NoError(t, err)
+	assert.Equal(t, UnixTimebasedVer7, next.Version())
+
+}
@@ -0,0 +1,146 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"math/bits"
+	"time"
+)
+
+const (
+	maxEpochTicks = int64(1) << 48 - 1
+)
+
+/**
+	Time layout of Custom UUIDs (version 8) with the caller-specified epoch and tick size
+
+	Layout: 48-bit number of ticks since the epoch in the place of unix_ts_ms of version 7,
+	12 random bits in rand_a and 62 random bits in rand_b, so UUIDs are ordered by time in MarshalBinary
+	order like version 7; range is 2^48 ticks since the epoch, like 8.9 million years of seconds since 2020-01-01
+ */
+
+type Epoch struct {
+	start time.Time
+	tick  time.Duration
+}
+
+/**
+	Creates time layout with the epoch and the positive tick size, like NewEpoch(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Second)
+ */
+
+func NewEpoch(start time.Time, tick time.Duration) (Epoch, error) {
+	if tick <= 0 {
+		return Epoch{}, errors.Errorf("non-positive epoch tick %s", tick)
+	}
+	return Epoch{ start: start, tick: tick }, nil
+}
+
+/**
+	Gets start of the epoch
+ */
+
+func (this Epoch) Start() time.Time {
+	return this.start
+}
+
+/**
+	Gets tick size of the epoch
+ */
+
+func (this Epoch) Tick() time.Duration {
+	return this.tick
+}
+
+/**
+	Gets number of ticks since the epoch truncated to the tick size, returns ErrorTimeOutOfRange
+	for times before the epoch and after 2^48 ticks
+ */
+
+func (this Epoch) Ticks(t time.Time) (int64, error) {
+	if t.Before(this.start) {
+		return 0, ErrorTimeOutOfRange
+	}
+	// 128-bit nanoseconds since the epoch do not overflow after 292 years of time.Duration
+	seconds := t.Unix() - this.start.Unix()
+	nanos := t.Nanosecond() - this.start.Nanosecond()
+	if nanos < 0 {
+		seconds, nanos = seconds - 1, nanos + int(time.Second)
+	}
+	hi, lo := bits.Mul64(uint64(seconds), uint64(time.Second))
+	lo, carry := bits.Add64(lo, uint64(nanos), 0)
+	hi += carry
+	if hi >= uint64(this.tick) {
+		return 0, ErrorTimeOutOfRange
+	}
+	ticks, _ := bits.Div64(hi, lo, uint64(this.tick))
+	if ticks > uint64(maxEpochTicks) {
+		return 0, ErrorTimeOutOfRange
+	}
+	return int64(ticks), nil
+}
+
+/**
+	Creates Custom UUID (version 8) for the time with random bits
+ */
+
+func (this Epoch) UUID(t time.Time) (UUID, error) {
+	var randomBytes [16]byte
+	if err := readRandom(randomBytes[:]); err != nil {
+		return Empty, err
+	}
+	uuid := UUID{
+		mostSigBits:  binary.BigEndian.Uint64(randomBytes[:8]),
+		leastSigBits: binary.BigEndian.Uint64(randomBytes[8:]) & counterMask | variantIETFBits,
+	}
+	return uuid, this.SetTime(&uuid, t)
+}
+
+/**
+	Stores ticks of the time since the epoch in the Custom UUID (version 8), sets version 8
+
+	Random bits of rand_a and rand_b are kept
+ */
+
+func (this Epoch) SetTime(uuid *UUID, t time.Time) error {
+	ticks, err := this.Ticks(t)
+	if err != nil {
+		return err
+	}
+	uuid.mostSigBits = uint64(ticks) << 16 | uint64(CustomVer8) << 12 | uuid.mostSigBits & 0x0FFF
+	return nil
+}
+
+/**
+	Gets time of the Custom UUID (version 8) truncated to the tick size, returns ErrorUnexpectedVersion for other versions
+	and ErrorTimeOutOfRange if the time does not fit in to time.Time
+ */
+
+func (this Epoch) Time(uuid UUID) (time.Time, error) {
+	if uuid.Version() != CustomVer8 {
+		return time.Time{}, ErrorUnexpectedVersion
+	}
+	hi, lo := bits.Mul64(uuid.mostSigBits >> 16, uint64(this.tick))
+	if hi >= uint64(time.Second) {
+		return time.Time{}, ErrorTimeOutOfRange
+	}
+	seconds, nanos := bits.Div64(hi, lo, uint64(time.Second))
+	return time.Unix(this.start.Unix() + int64(seconds), int64(this.start.Nanosecond()) + int64(nanos)).In(this.start.Location()), nil
+}
@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpoch(t *testing.T) {
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch, err := NewEpoch(start, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, start, epoch.Start())
+	assert.Equal(t, time.Second, epoch.Tick())
+
+	at := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	uuid, err := epoch.UUID(at)
+	assert.NoError(t, err)
+	assert.Equal(t, CustomVer8, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, uint64(at.Unix() - start.Unix()), uuid.mostSigBits >> 16)
+
+	actual, err := epoch.Time(uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, at.Truncate(time.Second), actual)
+
+	// ordered by time in MarshalBinary order
+
+	later, err := epoch.UUID(at.Add(time.Second))
+	assert.NoError(t, err)
+	assert.True(t, uuid.Compare(later) < 0)
+
+	// random bits are kept
+
+	kept := uuid
+	assert.NoError(t, epoch.SetTime(&kept, start))
+	assert.Equal(t, uuid.mostSigBits & 0x0FFF, kept.mostSigBits & 0x0FFF)
+	assert.Equal(t, uuid.leastSigBits, kept.leastSigBits)
+	actual, _ = epoch.Time(kept)
+	assert.Equal(t, start, actual)
+
+	// sub-second ticks and epoch with nanoseconds
+
+	epoch, _ = NewEpoch(start.Add(700 * time.Millisecond), 10 * time.Millisecond)
+	ticks, err := epoch.Ticks(start.Add(time.Second + 25 * time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(32), ticks)
+	uuid, _ = epoch.UUID(start.Add(time.Second + 25 * time.Millisecond))
+	actual, _ = epoch.Time(uuid)
+	assert.Equal(t, start.Add(time.Second + 20 * time.Millisecond), actual)
+
+	// range beyond time.Duration
+
+	far := start.AddDate(1000, 0, 0)
+	epoch, _ = NewEpoch(start, time.Second)
+	uuid, err = epoch.UUID(far)
+	assert.NoError(t, err)
+	actual, _ = epoch.Time(uuid)
+	assert.Equal(t, far, actual)
+
+	// errors
+
+	_, err = epoch.Ticks(start.Add(-time.Nanosecond))
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+	microsEpoch, _ := NewEpoch(start, time.Microsecond)
+	_, err = microsEpoch.Ticks(start.Add(time.Duration(maxEpochTicks + 1) * time.Microsecond))
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+	ticks, err = microsEpoch.Ticks(start.Add(time.Duration(maxEpochTicks) * time.Microsecond))
+	assert.NoError(t, err)
+	assert.Equal(t, maxEpochTicks, ticks)
+
+	nanosEpoch, _ := NewEpoch(start, time.Nanosecond)
+	_, err = nanosEpoch.Ticks(start.AddDate(10, 0, 0))
+	assert.Equal(t, ErrorTimeOutOfRange, err)
+
+	_, err = epoch.Time(NewUUID(UnixTimebasedVer7))
+	assert.Equal(t, ErrorUnexpectedVersion, err)
+
+	_, err = NewEpoch(start, 0)
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,99 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+/**
+	Randomly generated UUID (version 4) generator backed by the non-cryptographic ChaCha8 of math/rand/v2
+
+	Used for test data and simulations where throughput matters more than the crypto strength of randomness,
+	do not use it for identifiers exposed to untrusted parties
+ */
+
+type FastRandomGenerator struct {
+	mutex sync.Mutex
+	rng   rand.Source
+}
+
+const (
+
+	/**
+		Second word of the PCG seed in NewSeededRandomGenerator, must never change to keep streams reproducible
+	 */
+
+	seededRandomStream = uint64(0x9E3779B97F4A7C15)
+)
+
+/**
+	Creates new fast random generator seeded from the pseudo-random cryptographic generator
+
+	Returns error if the seed could not be read, so generators never share the zero seed
+ */
+
+func NewFastRandomGenerator() (*FastRandomGenerator, error) {
+	var seed [32]byte
+	if err := readRandom(seed[:]); err != nil {
+		return nil, err
+	}
+	return &FastRandomGenerator{
+		rng: rand.NewChaCha8(seed),
+	}, nil
+}
+
+/**
+	Creates new generator of the reproducible stream of randomly generated UUIDs backed by PCG of math/rand/v2
+
+	The same seed gives the same stream of UUIDs across runs, used in snapshot tests and simulations
+ */
+
+func NewSeededRandomGenerator(seed uint64) *FastRandomGenerator {
+	return &FastRandomGenerator{
+		rng: rand.NewPCG(seed, seededRandomStream),
+	}
+}
+
+/**
+	Issues next randomly generated UUID
+ */
+
+func (this *FastRandomGenerator) Next() (UUID, error) {
+
+	done := traceStart(TraceGenerate, "FastRandomGenerator.Next")
+	defer done(nil)
+
+	this.mutex.Lock()
+	mostSigBits, leastSigBits := this.rng.Uint64(), this.rng.Uint64()
+	this.mutex.Unlock()
+
+	return randomUUIDFromWords(mostSigBits, leastSigBits), nil
+}
+
+/**
+	Creates randomly generated UUID (version 4) from the 128 random bits, 6 of them are replaced by version and variant
+ */
+
+func randomUUIDFromWords(mostSigBits, leastSigBits uint64) (uuid UUID) {
+	uuid.mostSigBits = mostSigBits &^ versionMask | uint64(RandomlyGeneratedVer4) << 12
+	uuid.leastSigBits = leastSigBits & counterMask | variantIETFBits
+	return uuid
+}
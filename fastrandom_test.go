@@ -0,0 +1,111 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"io"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFastRandomGenerator(t *testing.T) {
+
+	gen, err := NewFastRandomGenerator()
+	assert.NoError(t, err)
+	var _ Generator = gen
+
+	seen := make(map[UUID]bool)
+	for i := 0; i != 1000; i = i + 1 {
+		uuid, err := gen.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, RandomlyGeneratedVer4, uuid.Version())
+		assert.Equal(t, IETF, uuid.Variant())
+		assert.False(t, seen[uuid])
+		seen[uuid] = true
+	}
+
+	// the same seed gives the same stream
+
+	first := &FastRandomGenerator{ rng: rand.NewChaCha8([32]byte{ 1 }) }
+	second := &FastRandomGenerator{ rng: rand.NewChaCha8([32]byte{ 1 }) }
+	for i := 0; i != 100; i = i + 1 {
+		a, _ := first.Next()
+		b, _ := second.Next()
+		assert.True(t, a.Equal(b))
+	}
+
+	// seeded stream is reproducible across runs
+
+	seeded := NewSeededRandomGenerator(42)
+	var _ Generator = seeded
+	for _, expected := range []string{
+		"55f94932-b92b-4500-a0be-0fd7ce6d26f1",
+		"162ad53b-615a-45f5-937e-60713d238914",
+		"b38be8da-7318-4315-b97b-708fa102f7a4",
+	} {
+		uuid, err := seeded.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, uuid.String())
+	}
+
+	a, _ := NewSeededRandomGenerator(1).Next()
+	b, _ := NewSeededRandomGenerator(2).Next()
+	assert.False(t, a.Equal(b))
+
+	// safe for concurrent use
+
+	var wg sync.WaitGroup
+	for i := 0; i != 8; i = i + 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j != 100; j = j + 1 {
+				gen.Next()
+			}
+		}()
+	}
+	wg.Wait()
+
+	uuid := randomUUIDFromWords(^uint64(0), ^uint64(0))
+	assert.Equal(t, "ffffffff-ffff-4fff-bfff-ffffffffffff", uuid.String())
+
+}
+
+func TestFastRandomGeneratorSeedFailure(t *testing.T) {
+
+	defer func(reader io.Reader) { randomReader = reader }(randomReader)
+
+	randomReader = &failingReader{ failures: randomReadAttempts }
+	gen, err := NewFastRandomGenerator()
+	assert.True(t, errors.Is(err, errEntropy))
+	assert.Nil(t, gen)
+
+}
+
+func BenchmarkFastRandomGenerator(b *testing.B) {
+	gen, _ := NewFastRandomGenerator()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		gen.Next()
+	}
+}
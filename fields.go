@@ -0,0 +1,94 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"time"
+)
+
+/**
+	Structured decomposition of UUID for debugging dashboards and admin tooling
+
+	Has* flags tell whether the field is meaningful for the version of UUID,
+	fields that are not meaningful have zero values
+ */
+
+type Fields struct {
+	Version          Version
+	Variant          Variant
+
+	Time             time.Time
+	HasTime          bool
+
+	ClockSequence    int
+	HasClockSequence bool
+
+	Node             int64
+	HasNode          bool
+
+	Counter          int64
+	HasCounter       bool
+
+	Domain           Domain
+	ID               uint32
+	HasDomain        bool
+}
+
+/**
+	Gets structured decomposition of UUID according to its version
+
+	Time is decoded for versions 1, 6 and 7, clock sequence and node for versions 1, 2 and 6,
+	counter for version 1, domain and identifier for version 2
+ */
+
+func (this UUID) Fields() Fields {
+
+	fields := Fields{
+		Version: this.Version(),
+		Variant: this.Variant(),
+	}
+
+	if fields.Variant != IETF {
+		return fields
+	}
+
+	if unixTime100Nanos, ok := this.embeddedUnixTime100Nanos(); ok {
+		fields.Time = time.Unix(unixTime100Nanos / one100NanosInSecond, unixTime100Nanos % one100NanosInSecond * 100)
+		fields.HasTime = true
+	}
+
+	switch fields.Version {
+
+	case TimebasedVer1:
+		fields.ClockSequence, fields.HasClockSequence = this.ClockSequence(), true
+		fields.Node, fields.HasNode = this.Node(), true
+		fields.Counter, fields.HasCounter = this.Counter(), true
+
+	case DCESecurityVer2:
+		fields.ClockSequence, fields.HasClockSequence = int(this.leastSigBits >> 56) & dceClockSequenceBits, true
+		fields.Node, fields.HasNode = this.Node(), true
+		fields.Domain, fields.ID, fields.HasDomain = this.Domain(), this.ID(), true
+
+	case ReorderedTimebasedVer6:
+		fields.ClockSequence, fields.HasClockSequence = this.ClockSequence(), true
+		fields.Node, fields.HasNode = this.Node(), true
+	}
+
+	return fields
+}
@@ -0,0 +1,81 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFields(t *testing.T) {
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	v1.SetClockSequence(0x1234)
+	v1.SetNode(0x123456789ABC)
+
+	fields := v1.Fields()
+	assert.Equal(t, TimebasedVer1, fields.Version)
+	assert.Equal(t, IETF, fields.Variant)
+	assert.True(t, fields.HasTime)
+	assert.Equal(t, now, fields.Time.UTC())
+	assert.True(t, fields.HasClockSequence)
+	assert.Equal(t, 0x1234, fields.ClockSequence)
+	assert.True(t, fields.HasNode)
+	assert.Equal(t, int64(0x123456789ABC), fields.Node)
+	assert.True(t, fields.HasCounter)
+	assert.Equal(t, v1.Counter(), fields.Counter)
+	assert.False(t, fields.HasDomain)
+
+	v2 := CreateDCESecurity(Group, 1000, now, 0x15, 0x123456789ABC)
+	fields = v2.Fields()
+	assert.False(t, fields.HasTime)
+	assert.Equal(t, 0x15, fields.ClockSequence)
+	assert.Equal(t, int64(0x123456789ABC), fields.Node)
+	assert.True(t, fields.HasDomain)
+	assert.Equal(t, Group, fields.Domain)
+	assert.Equal(t, uint32(1000), fields.ID)
+	assert.False(t, fields.HasCounter)
+
+	v6, _ := MinUUIDForTime(now, ReorderedTimebasedVer6)
+	fields = v6.Fields()
+	assert.Equal(t, ReorderedTimebasedVer6, fields.Version)
+	assert.True(t, fields.HasTime)
+	assert.Equal(t, now, fields.Time.UTC())
+	assert.True(t, fields.HasNode)
+	assert.False(t, fields.HasCounter)
+
+	v7, _ := MinUUIDForTime(now, UnixTimebasedVer7)
+	fields = v7.Fields()
+	assert.True(t, fields.HasTime)
+	assert.Equal(t, now.Truncate(time.Millisecond), fields.Time.UTC())
+	assert.False(t, fields.HasNode)
+	assert.False(t, fields.HasClockSequence)
+
+	fields = NewV5(NamespaceDNS, "www.example.com").Fields()
+	assert.Equal(t, Fields{ Version: NamebasedVer5, Variant: IETF }, fields)
+
+	fields = MaxUUID.Fields()
+	assert.Equal(t, Fields{ Version: UnknownVersion, Variant: FutureReserved }, fields)
+
+}
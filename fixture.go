@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"sync"
+	"time"
+)
+
+/**
+	Generator of the predictable sequence of Time-based UUIDs (version 1) for tests
+
+	Every UUID has the base time and the counter incremented from zero on each call,
+	so golden files and assertion messages stay stable across runs; sequence is ordered
+	in MarshalSortableBinary and CompareTimeuuid orders
+ */
+
+type FixtureGenerator struct {
+	mutex   sync.Mutex
+	base    UUID
+	counter uint64
+}
+
+/**
+	Creates new fixture generator for the base time
+ */
+
+func NewFixtureGenerator(base time.Time) *FixtureGenerator {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(base)
+	return &FixtureGenerator{
+		base: uuid,
+	}
+}
+
+/**
+	Issues next UUID of the fixture sequence
+ */
+
+func (this *FixtureGenerator) Next() (UUID, error) {
+
+	done := traceStart(TraceGenerate, "FixtureGenerator.Next")
+	defer done(nil)
+
+	this.mutex.Lock()
+	counter := this.counter
+	this.counter++
+	this.mutex.Unlock()
+
+	uuid := this.base
+	uuid.SetCounterUnsigned(counter)
+	return uuid, nil
+}
+
+/**
+	Restarts the sequence from the first UUID
+ */
+
+func (this *FixtureGenerator) Reset() {
+	this.mutex.Lock()
+	this.counter = 0
+	this.mutex.Unlock()
+}
@@ -0,0 +1,60 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtureGenerator(t *testing.T) {
+
+	base := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+
+	gen := NewFixtureGenerator(base)
+	var _ Generator = gen
+
+	first, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "968b8086-a91b-11ee-8080-808080808080", first.String())
+	assert.Equal(t, base.Truncate(100 * time.Nanosecond), first.Time().UTC())
+
+	second, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "968b8086-a91b-11ee-8080-808080808081", second.String())
+	assert.Equal(t, int64(1), second.Counter())
+
+	prev, _ := first.MarshalSortableBinary()
+	next, _ := second.MarshalSortableBinary()
+	assert.True(t, bytes.Compare(prev, next) < 0)
+	assert.Equal(t, -1, CompareTimeuuid(first, second))
+
+	// the same sequence after reset and for the new generator
+
+	gen.Reset()
+	actual, _ := gen.Next()
+	assert.True(t, first.Equal(actual))
+
+	actual, _ = NewFixtureGenerator(base).Next()
+	assert.True(t, first.Equal(actual))
+
+}
@@ -0,0 +1,377 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+/**
+	Text format of UUID representation
+ */
+
+type Format int
+
+// Constants returned by DetectFormat, binary formats are never detected from text.
+const (
+	UnknownFormat = Format(iota)
+	CanonicalFormat           // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	BracedFormat              // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+	URNFormat                 // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	CompactFormat             // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	Base64Format              // 22 characters of unpadded URL-safe base64
+	SortableTextFormat        // xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx of sortable binary
+	RawHexFormat              // 0xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	Base32Format              // 26 characters of Crockford Base32
+	Base58Format              // up to 22 characters of Base58, never detected because of base64 alphabet overlap
+	BinaryFormat              // 16 bytes of MarshalBinary
+	SortableBinaryFormat      // 16 bytes of MarshalSortableBinary
+)
+
+var (
+	ErrorUnsupportedFormat = errors.New("unsupported format")
+)
+
+/**
+	Classifies string representation of UUID by shape and alphabet without decoding it
+
+	Used to log and meter formats that clients actually send
+ */
+
+func DetectFormat(s string) Format {
+
+	switch len(s) {
+
+	case 36:
+		if isCanonicalText(s) {
+			return CanonicalFormat
+		}
+
+	case 36 + 2:
+		if s[0] == '{' && s[37] == '}' && isCanonicalText(s[1:37]) {
+			return BracedFormat
+		}
+
+	case 36 + 9:
+		if strings.EqualFold(s[:9], "urn:uuid:") && isCanonicalText(s[9:]) {
+			return URNFormat
+		}
+
+	case 32:
+		if isHexText(s) {
+			return CompactFormat
+		}
+
+	case 32 + 2:
+		if (s[:2] == "0x" || s[:2] == "0X") && isHexText(s[2:]) {
+			return RawHexFormat
+		}
+
+	case 32 + 1:
+		if s[16] == '-' && isHexText(s[:16]) && isHexText(s[17:]) {
+			return SortableTextFormat
+		}
+
+	case 22:
+		if isBase64URLText(s) {
+			return Base64Format
+		}
+
+	case 26:
+		if isBase32Text(s) {
+			return Base32Format
+		}
+
+	}
+
+	return UnknownFormat
+}
+
+/**
+	Gets format name
+ */
+
+func (f Format) String() string {
+	switch f {
+	case UnknownFormat:
+		return "UnknownFormat"
+	case CanonicalFormat:
+		return "CanonicalFormat"
+	case BracedFormat:
+		return "BracedFormat"
+	case URNFormat:
+		return "URNFormat"
+	case CompactFormat:
+		return "CompactFormat"
+	case Base64Format:
+		return "Base64Format"
+	case SortableTextFormat:
+		return "SortableTextFormat"
+	case RawHexFormat:
+		return "RawHexFormat"
+	case Base32Format:
+		return "Base32Format"
+	case Base58Format:
+		return "Base58Format"
+	case BinaryFormat:
+		return "BinaryFormat"
+	case SortableBinaryFormat:
+		return "SortableBinaryFormat"
+	}
+	return fmt.Sprintf("BadFormat%d", int(f))
+}
+
+/**
+	Checks if format is binary, otherwise it is text
+ */
+
+func (f Format) Binary() bool {
+	return f == BinaryFormat || f == SortableBinaryFormat
+}
+
+/**
+	Appends UUID in the specific format to the slice
+ */
+
+func (this UUID) AppendFormat(dst []byte, format Format) ([]byte, error) {
+
+	var buf [36 + 9]byte
+	var err error
+
+	switch format {
+
+	case CanonicalFormat:
+		return this.AppendText(dst)
+
+	case BracedFormat:
+		buf[0] = '{'
+		err = this.MarshalTextTo(buf[1:])
+		buf[37] = '}'
+		return append(dst, buf[:38]...), err
+
+	case URNFormat:
+		copy(buf[:], "urn:uuid:")
+		err = this.MarshalTextTo(buf[9:])
+		return append(dst, buf[:]...), err
+
+	case CompactFormat:
+		return this.AppendCompactText(dst), nil
+
+	case RawHexFormat:
+		return this.AppendCompactText(append(dst, '0', 'x')), nil
+
+	case Base64Format:
+		return this.appendBase64(dst), nil
+
+	case Base32Format:
+		return this.appendBase32(dst), nil
+
+	case Base58Format:
+		return this.appendBase58(dst), nil
+
+	case SortableTextFormat:
+		err = this.MarshalSortableTextTo(buf[:])
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, buf[:33]...), nil
+
+	case BinaryFormat:
+		err = this.MarshalBinaryTo(buf[:])
+		return append(dst, buf[:16]...), err
+
+	case SortableBinaryFormat:
+		err = this.MarshalSortableBinaryTo(buf[:])
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, buf[:16]...), nil
+
+	default:
+		return dst, errors.Wrap(ErrorUnsupportedFormat, format.String())
+	}
+}
+
+/**
+	Stores UUID in the specific format
+
+	Text formats return bytes of the string
+ */
+
+func (this UUID) MarshalFormat(format Format) ([]byte, error) {
+	done := traceStart(TraceMarshal, "MarshalFormat")
+	dst, err := this.AppendFormat(nil, format)
+	done(err)
+	return dst, err
+}
+
+/**
+	Parses UUID stored in the specific format
+
+	Unlike ParseBytes accepts only the exact format
+ */
+
+func ParseFormat(src []byte, format Format) (uuid UUID, err error) {
+	done := traceStart(TraceParse, "ParseFormat")
+	uuid, err = parseFormat(src, format)
+	done(err)
+	return uuid, err
+}
+
+/**
+	Parses UUID in any supported format detected by contents of the input
+
+	Text formats are detected by DetectFormat, 16 bytes are SortableBinaryFormat when they hold
+	the Time-based UUID that passes Validate in the sortable layout, otherwise BinaryFormat;
+	Base58Format is never detected, use ParseFormat for it
+
+	return UUID and the detected format
+ */
+
+func ParseAny(input []byte) (uuid UUID, format Format, err error) {
+	done := traceStart(TraceParse, "ParseAny")
+	uuid, format, err = parseAny(input)
+	done(err)
+	return uuid, format, err
+}
+
+func parseAny(input []byte) (UUID, Format, error) {
+
+	format := DetectFormat(string(input))
+
+	if format == UnknownFormat && len(input) == 16 {
+		format = detectBinaryFormat(input)
+	}
+
+	if format == UnknownFormat {
+		return Empty, UnknownFormat, errors.Wrapf(ErrorUnsupportedFormat, "%d bytes", len(input))
+	}
+
+	uuid, err := parseFormat(input, format)
+	return uuid, format, err
+}
+
+func detectBinaryFormat(data []byte) Format {
+	var uuid UUID
+	if uuid.UnmarshalSortableBinary(data) == nil && uuid.Validate() == nil {
+		return SortableBinaryFormat
+	}
+	return BinaryFormat
+}
+
+func parseFormat(src []byte, format Format) (UUID, error) {
+
+	var uuid UUID
+	var err error
+
+	switch format {
+
+	case CanonicalFormat:
+		if len(src) != 36 {
+			return Empty, ErrorWrongLen
+		}
+
+	case BracedFormat:
+		if len(src) != 36 + 2 {
+			return Empty, ErrorWrongLen
+		}
+		if src[0] != '{' || src[37] != '}' {
+			return Empty, errors.Errorf("invalid braced UUID format: %q", src)
+		}
+
+	case URNFormat:
+		if len(src) != 36 + 9 {
+			return Empty, ErrorWrongLen
+		}
+
+	case CompactFormat:
+		return ParseCompactBytes(src)
+
+	case RawHexFormat:
+		if len(src) != 32 + 2 {
+			return Empty, ErrorWrongLen
+		}
+		if src[0] != '0' || (src[1] != 'x' && src[1] != 'X') {
+			return Empty, errors.Errorf("invalid hex prefix in %q", src)
+		}
+		return ParseCompactBytes(src[2:])
+
+	case Base64Format:
+		return decodeBase64(src)
+
+	case Base58Format:
+		return decodeBase58(src)
+
+	case SortableTextFormat:
+		err = uuid.UnmarshalSortableText(src)
+		return uuid, err
+
+	case Base32Format:
+		if len(src) != 26 {
+			return Empty, ErrorWrongLen
+		}
+		return decodeBase32(src)
+
+	case BinaryFormat:
+		if len(src) != 16 {
+			return Empty, ErrorWrongLen
+		}
+		err = uuid.UnmarshalBinary(src)
+		return uuid, err
+
+	case SortableBinaryFormat:
+		if len(src) != 16 {
+			return Empty, ErrorWrongLen
+		}
+		err = uuid.UnmarshalSortableBinary(src)
+		return uuid, err
+
+	default:
+		return Empty, errors.Wrap(ErrorUnsupportedFormat, format.String())
+	}
+
+	return parseBytes(src)
+}
+
+func isCanonicalText(s string) bool {
+	return s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-' &&
+		isHexText(s[:8]) && isHexText(s[9:13]) && isHexText(s[14:18]) && isHexText(s[19:23]) && isHexText(s[24:])
+}
+
+func isHexText(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func isBase64URLText(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
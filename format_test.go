@@ -0,0 +1,147 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectFormat(t *testing.T) {
+
+	assert.Equal(t, CanonicalFormat, DetectFormat("534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.Equal(t, CanonicalFormat, DetectFormat("534B44A1-9BF1-3D20-B71E-CC4EB77C572F"))
+	assert.Equal(t, BracedFormat, DetectFormat("{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}"))
+	assert.Equal(t, URNFormat, DetectFormat("urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.Equal(t, URNFormat, DetectFormat("URN:UUID:534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.Equal(t, CompactFormat, DetectFormat("534b44a19bf13d20b71ecc4eb77c572f"))
+	assert.Equal(t, RawHexFormat, DetectFormat("0x534b44a19bf13d20b71ecc4eb77c572f"))
+	assert.Equal(t, SortableTextFormat, DetectFormat("11b21dd213814000-0d450774f5ba30c5"))
+	assert.Equal(t, Base64Format, DetectFormat("U0tEoZvxPSC3HsxOt3xXLw"))
+
+	assert.Equal(t, UnknownFormat, DetectFormat(""))
+	assert.Equal(t, UnknownFormat, DetectFormat("534b44a1-9bf1-3d20-b71e-cc4eb77c572z"))
+	assert.Equal(t, UnknownFormat, DetectFormat("534b44a1x9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.Equal(t, UnknownFormat, DetectFormat("\"534b44a1-9bf1-3d20-b71e-cc4eb77c572f\""))
+	assert.Equal(t, UnknownFormat, DetectFormat("urn:uudi:534b44a1-9bf1-3d20-b71e-cc4eb77c572f"))
+	assert.Equal(t, UnknownFormat, DetectFormat("U0tEoZvxPSC3HsxOt3xXL="))
+
+	assert.Equal(t, "CanonicalFormat", CanonicalFormat.String())
+	assert.Equal(t, "BadFormat100", Format(100).String())
+
+}
+
+func TestMarshalFormat(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	expected := map[Format]string {
+		CanonicalFormat: "534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		BracedFormat: "{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}",
+		URNFormat: "urn:uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		CompactFormat: "534b44a19bf13d20b71ecc4eb77c572f",
+		RawHexFormat: "0x534b44a19bf13d20b71ecc4eb77c572f",
+		Base64Format: "U0tEoZvxPSC3HsxOt3xXLw",
+		Base32Format: "2K9D2A36ZH7MGBE7PC9TVQRNSF",
+	}
+
+	for format, text := range expected {
+
+		data, err := uuid.MarshalFormat(format)
+		assert.NoError(t, err)
+		assert.Equal(t, text, string(data))
+		assert.Equal(t, format, DetectFormat(text))
+
+		actual, err := ParseFormat(data, format)
+		assert.NoError(t, err)
+		assert.True(t, uuid.Equal(actual), format.String())
+
+		_, err = ParseFormat(data[1:], format)
+		assert.Error(t, err)
+	}
+
+	_, err = ParseFormat([]byte("[534b44a1-9bf1-3d20-b71e-cc4eb77c572f]"), BracedFormat)
+	assert.Error(t, err)
+
+	data, err := uuid.MarshalFormat(BinaryFormat)
+	assert.NoError(t, err)
+	actual, err := ParseFormat(data, BinaryFormat)
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	_, err = uuid.MarshalFormat(SortableBinaryFormat)
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	uuid.SetUnixTimeMillis(123)
+	data, err = uuid.MarshalFormat(SortableBinaryFormat)
+	assert.NoError(t, err)
+	actual, err = ParseFormat(data, SortableBinaryFormat)
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	assert.True(t, BinaryFormat.Binary())
+	assert.False(t, CanonicalFormat.Binary())
+
+	_, err = uuid.MarshalFormat(UnknownFormat)
+	assert.True(t, errors.Is(err, ErrorUnsupportedFormat))
+
+}
+
+func TestParseAny(t *testing.T) {
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(time.Now())
+	uuid.SetCounter(12345)
+
+	for _, format := range []Format{ CanonicalFormat, BracedFormat, URNFormat, CompactFormat, RawHexFormat, SortableTextFormat, Base64Format, Base32Format, BinaryFormat, SortableBinaryFormat } {
+		data, err := uuid.MarshalFormat(format)
+		assert.NoError(t, err)
+
+		actual, detected, err := ParseAny(data)
+		assert.NoError(t, err, format.String())
+		assert.Equal(t, format, detected)
+		assert.Equal(t, uuid, actual, format.String())
+	}
+
+	// random UUIDs in binary
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i != 100; i = i + 1 {
+		var data [16]byte
+		random.Read(data[:])
+		uuid := FromArray(data).Canonicalize(RandomlyGeneratedVer4)
+		data = uuid.Array()
+		actual, detected, err := ParseAny(data[:])
+		assert.NoError(t, err)
+		assert.Equal(t, BinaryFormat, detected)
+		assert.Equal(t, uuid, actual)
+	}
+
+	_, detected, err := ParseAny([]byte("not a uuid"))
+	assert.Equal(t, UnknownFormat, detected)
+	assert.True(t, errors.Is(err, ErrorUnsupportedFormat))
+
+}
@@ -0,0 +1,173 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/**
+	Format implements the fmt.Formatter interface.
+
+	%s, %v  canonical form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	%q      quoted canonical form
+	%x, %X  compact 32-character hex in lower or upper case
+	%+v     decomposed field dump
+
+	Width and '-' flag are supported for padding.
+ */
+
+func (this UUID) Format(f fmt.State, verb rune) {
+
+	var buf [64]byte
+	dst := buf[:0]
+
+	switch verb {
+
+	case 'v':
+		if f.Flag('+') {
+			dst = this.appendFields(dst)
+		} else {
+			dst, _ = this.AppendFormat(dst, CanonicalFormat)
+		}
+
+	case 's':
+		dst, _ = this.AppendFormat(dst, CanonicalFormat)
+
+	case 'q':
+		dst = append(dst, '"')
+		dst, _ = this.AppendFormat(dst, CanonicalFormat)
+		dst = append(dst, '"')
+
+	case 'x', 'X':
+		dst, _ = this.AppendFormat(dst, CompactFormat)
+		if verb == 'X' {
+			toUpperHex(dst)
+		}
+
+	default:
+		dst = append(dst, "%!"...)
+		dst = append(dst, string(verb)...)
+		dst = append(dst, "(timeuuid.UUID="...)
+		dst, _ = this.AppendFormat(dst, CanonicalFormat)
+		dst = append(dst, ')')
+	}
+
+	width, hasWidth := f.Width()
+	padding := width - len(dst)
+	if !hasWidth || padding <= 0 {
+		f.Write(dst)
+		return
+	}
+
+	spaces := make([]byte, padding)
+	for i := range spaces {
+		spaces[i] = ' '
+	}
+
+	if f.Flag('-') {
+		f.Write(dst)
+		f.Write(spaces)
+	} else {
+		f.Write(spaces)
+		f.Write(dst)
+	}
+}
+
+func (this UUID) appendFields(dst []byte) []byte {
+
+	dst = append(dst, "{uuid:"...)
+	dst, _ = this.AppendFormat(dst, CanonicalFormat)
+
+	switch {
+	case this.IsZero():
+		return append(dst, " special:Nil}"...)
+	case this.IsMax():
+		return append(dst, " special:Max}"...)
+	}
+
+	dst = append(dst, " version:"...)
+	dst = append(dst, this.Version().String()...)
+	dst = append(dst, " variant:"...)
+	dst = append(dst, this.Variant().String()...)
+
+	if this.Version() == TimebasedVer1 {
+		dst = append(dst, " time:"...)
+		dst = this.Time().UTC().AppendFormat(dst, time.RFC3339Nano)
+		dst = append(dst, " clockSequence:"...)
+		dst = strconv.AppendInt(dst, int64(this.ClockSequence()), 10)
+		dst = append(dst, " node:0x"...)
+		dst = strconv.AppendInt(dst, this.Node(), 16)
+		dst = append(dst, " counter:"...)
+		dst = strconv.AppendInt(dst, this.Counter(), 10)
+	} else {
+		dst = append(dst, " msb:0x"...)
+		dst = strconv.AppendUint(dst, this.mostSigBits, 16)
+		dst = append(dst, " lsb:0x"...)
+		dst = strconv.AppendUint(dst, this.leastSigBits, 16)
+	}
+
+	return append(dst, '}')
+}
+
+/**
+	Gets multi-line annotated breakdown of UUID for log forensics
+
+	Lines of the fields that are not meaningful for the version are omitted, see Fields
+ */
+
+func (this UUID) DebugString() string {
+
+	var sb strings.Builder
+	fields := this.Fields()
+
+	fmt.Fprintf(&sb, "uuid:           %s\n", this.String())
+
+	switch {
+	case this.IsZero():
+		sb.WriteString("special:        Nil\n")
+	case this.IsMax():
+		sb.WriteString("special:        Max\n")
+	}
+
+	fmt.Fprintf(&sb, "version:        %d (%s)\n", (this.mostSigBits & versionMask) >> 12, fields.Version.String())
+	fmt.Fprintf(&sb, "variant:        %s\n", fields.Variant.String())
+
+	if fields.HasTime {
+		fmt.Fprintf(&sb, "time:           %s\n", fields.Time.UTC().Format(time.RFC3339Nano))
+	}
+	if fields.HasClockSequence {
+		fmt.Fprintf(&sb, "clock sequence: 0x%04x (%d)\n", fields.ClockSequence, fields.ClockSequence)
+	}
+	if fields.HasNode {
+		fmt.Fprintf(&sb, "node:           %012x\n", fields.Node)
+	}
+	if fields.HasCounter {
+		fmt.Fprintf(&sb, "counter:        %d\n", fields.Counter)
+	}
+	if fields.HasDomain {
+		fmt.Fprintf(&sb, "domain:         %s\n", fields.Domain.String())
+		fmt.Fprintf(&sb, "id:             %d\n", fields.ID)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,83 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", fmt.Sprintf("%s", uuid))
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", fmt.Sprintf("%v", uuid))
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", fmt.Sprint(uuid))
+	assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", fmt.Sprintf("%v", &uuid))
+	assert.Equal(t, `"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"`, fmt.Sprintf("%q", uuid))
+	assert.Equal(t, "534b44a19bf13d20b71ecc4eb77c572f", fmt.Sprintf("%x", uuid))
+	assert.Equal(t, "534B44A19BF13D20B71ECC4EB77C572F", fmt.Sprintf("%X", uuid))
+	assert.Equal(t, "%!d(timeuuid.UUID=534b44a1-9bf1-3d20-b71e-cc4eb77c572f)", fmt.Sprintf("%d", uuid))
+	assert.Equal(t, "{uuid:534b44a1-9bf1-3d20-b71e-cc4eb77c572f version:NamebasedVer3 variant:IETF msb:0x534b44a19bf13d20 lsb:0xb71ecc4eb77c572f}", fmt.Sprintf("%+v", uuid))
+
+	assert.Equal(t, "[  534b44a1-9bf1-3d20-b71e-cc4eb77c572f]", fmt.Sprintf("[%38s]", uuid))
+	assert.Equal(t, "[534b44a1-9bf1-3d20-b71e-cc4eb77c572f  ]", fmt.Sprintf("[%-38s]", uuid))
+	assert.Equal(t, "[534b44a1-9bf1-3d20-b71e-cc4eb77c572f]", fmt.Sprintf("[%10s]", uuid))
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(123)
+	uuid.SetClockSequence(5)
+	uuid.SetNode(0xABCDEF)
+
+	assert.Equal(t, "{uuid:" + uuid.String() + " version:TimebasedVer1 variant:IETF time:1970-01-01T00:00:00.123Z clockSequence:5 node:0xabcdef counter:" + fmt.Sprint(uuid.Counter()) + "}", fmt.Sprintf("%+v", uuid))
+
+}
+
+func TestDebugString(t *testing.T) {
+
+	uuid, _ := Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	assert.Equal(t, `uuid:           6ba7b810-9dad-11d1-80b4-00c04fd430c8
+version:        1 (TimebasedVer1)
+variant:        IETF
+time:           1998-02-04T22:13:53.1511824Z
+clock sequence: 0x00b4 (180)
+node:           00c04fd430c8
+counter:        14777714633650248
+`, uuid.DebugString())
+
+	assert.Equal(t, `uuid:           2ed6657d-e927-568b-95e1-2665a8aea6a2
+version:        5 (NamebasedVer5)
+variant:        IETF
+`, NewV5(NamespaceDNS, "www.example.com").DebugString())
+
+	uuid = CreateDCESecurity(Person, 501, time.Unix(0, 0), 1, 0xABCDEF)
+	assert.Contains(t, uuid.DebugString(), "domain:         Person\nid:             501\n")
+	assert.NotContains(t, uuid.DebugString(), "time:")
+
+	assert.Contains(t, Empty.DebugString(), "special:        Nil\n")
+	assert.Contains(t, MaxUUID.DebugString(), "special:        Max\n")
+
+}
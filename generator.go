@@ -0,0 +1,251 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+)
+
+/**
+	Generator issues new UUIDs
+
+	Implementations are safe for concurrent use
+ */
+
+type Generator interface {
+
+	/**
+		Issues next UUID
+	 */
+
+	Next() (UUID, error)
+}
+
+const (
+
+	/**
+		Minimal step in nanoseconds that guarantees increase of the 12-bit sub-millisecond fraction in version 7
+	 */
+
+	subMillisStepNanos = int64(245)
+
+	subMillisFractionBits = uint64(0x0FFF)
+)
+
+/**
+	Policy of TimebasedGenerator when all clock sequence values of the same 100-nanosecond tick are issued
+ */
+
+type OverflowPolicy int
+
+// Constants passed to SetOverflowPolicy.
+const (
+
+	/**
+		Waits for the next tick of the clock, default policy
+	 */
+
+	OverflowSpin = OverflowPolicy(iota)
+
+	/**
+		Returns ErrorSequenceOverflow
+	 */
+
+	OverflowError
+)
+
+var (
+	ErrorSequenceOverflow = errors.New("clock sequence overflow in the same 100-nanosecond tick")
+)
+
+/**
+	Time-based UUID (version 1) generator
+
+	Keeps the last issued timestamp and increments clock sequence every time when the clock regressed
+	or the next UUID is requested within the same 100-nanosecond tick, as described in RFC 4122 4.2.1
+
+	Requests within the same tick spill in to the clock sequence, so up to 16384 distinct UUIDs are issued
+	per tick; on overflow generator follows OverflowPolicy instead of producing duplicates
+ */
+
+type TimebasedGenerator struct {
+	mutex            sync.Mutex
+	clock            func() time.Time
+	node             int64
+	clockSequence    int
+	lastTime100Nanos int64
+	tickSequence     int
+	overflowPolicy   OverflowPolicy
+	// high bits of clock sequence fixed by ClockSequenceSlot
+	slotMask         int
+}
+
+/**
+	Creates new time-based UUID generator for the specific node and initial clock sequence
+ */
+
+func NewTimebasedGenerator(node int64, clockSequence int) *TimebasedGenerator {
+	return &TimebasedGenerator{
+		clock:         time.Now,
+		node:          node & nodeMask,
+		clockSequence: clockSequence & clockSequenceBits,
+	}
+}
+
+/**
+	Gets node used by generator
+ */
+
+func (this *TimebasedGenerator) Node() int64 {
+	return this.node
+}
+
+/**
+	Gets current clock sequence of generator
+ */
+
+func (this *TimebasedGenerator) ClockSequence() int {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	return this.clockSequence
+}
+
+/**
+	Sets policy on overflow of clock sequence within the same tick
+ */
+
+func (this *TimebasedGenerator) SetOverflowPolicy(policy OverflowPolicy) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.overflowPolicy = policy
+}
+
+/**
+	Issues next time-based UUID
+ */
+
+func (this *TimebasedGenerator) Next() (uuid UUID, err error) {
+
+	done := traceStart(TraceGenerate, "TimebasedGenerator.Next")
+	defer func() { done(err) }()
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetTime(this.clock())
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	time100Nanos := uuid.Time100Nanos()
+
+	for time100Nanos == this.lastTime100Nanos && this.tickSequence == clockSequenceBits &^ this.slotMask {
+		if this.overflowPolicy == OverflowError {
+			return Empty, ErrorSequenceOverflow
+		}
+		runtime.Gosched()
+		uuid.SetTime(this.clock())
+		time100Nanos = uuid.Time100Nanos()
+	}
+
+	switch {
+	case time100Nanos == this.lastTime100Nanos:
+		this.tickSequence++
+		this.clockSequence = this.nextClockSequence()
+	case time100Nanos < this.lastTime100Nanos:
+		this.tickSequence = 0
+		this.clockSequence = this.nextClockSequence()
+	default:
+		this.tickSequence = 0
+	}
+	this.lastTime100Nanos = time100Nanos
+
+	uuid.SetClockSequence(this.clockSequence)
+	uuid.SetNode(this.node)
+	return uuid, nil
+}
+
+/**
+	Increments clock sequence keeping the bits of the slot
+ */
+
+func (this *TimebasedGenerator) nextClockSequence() int {
+	return this.clockSequence & this.slotMask | (this.clockSequence + 1) & clockSequenceBits &^ this.slotMask
+}
+
+/**
+	Unix time-based UUID (version 7) generator
+
+	Layout follows RFC 9562 method 3: 48-bit unix time in milliseconds, 12-bit sub-millisecond fraction
+	in rand_a and 62 random bits in rand_b. Fraction is increased on every call within the same clock value,
+	therefore issued UUIDs are strictly increasing for the single generator.
+ */
+
+type UnixTimebasedGenerator struct {
+	mutex     sync.Mutex
+	clock     func() time.Time
+	random    io.Reader
+	lastNanos int64
+}
+
+/**
+	Creates new unix time-based UUID generator that uses pseudo-random cryptographic generator
+ */
+
+func NewUnixTimebasedGenerator() *UnixTimebasedGenerator {
+	return &UnixTimebasedGenerator{
+		clock:  time.Now,
+		random: rand.Reader,
+	}
+}
+
+/**
+	Issues next unix time-based UUID
+ */
+
+func (this *UnixTimebasedGenerator) Next() (uuid UUID, err error) {
+
+	done := traceStart(TraceGenerate, "UnixTimebasedGenerator.Next")
+	defer func() { done(err) }()
+
+	var randomBytes [8]byte
+	if _, err = io.ReadFull(this.random, randomBytes[:]); err != nil {
+		return Empty, err
+	}
+
+	nanos := this.clock().UnixNano()
+
+	this.mutex.Lock()
+	if nanos < this.lastNanos + subMillisStepNanos {
+		nanos = this.lastNanos + subMillisStepNanos
+	}
+	this.lastNanos = nanos
+	this.mutex.Unlock()
+
+	millis := nanos / int64(time.Millisecond)
+	fraction := uint64(nanos % int64(time.Millisecond)) * (subMillisFractionBits + 1) / uint64(time.Millisecond)
+
+	uuid.mostSigBits = uint64(millis) << 16 | unixTimebasedVersionBits | fraction
+	uuid.leastSigBits = binary.BigEndian.Uint64(randomBytes[:]) & counterMask | variantIETFBits
+	return uuid, nil
+}
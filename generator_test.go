@@ -0,0 +1,147 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimebasedGenerator(t *testing.T) {
+
+	gen := NewTimebasedGenerator(int64(0x0001AABBCCDDEEFF), 0x13FFF)
+	assert.Equal(t, int64(0x0000AABBCCDDEEFF), gen.Node())
+	assert.Equal(t, 0x3FFF, gen.ClockSequence())
+
+	now := time.Now()
+	gen.clock = func() time.Time { return now }
+
+	first, err := gen.Next()
+	if err != nil {
+		t.Fatal("fail to generate uuid ", err)
+	}
+
+	assert.Equal(t, TimebasedVer1, first.Version())
+	assert.Equal(t, IETF, first.Variant())
+	assert.Equal(t, now.UnixNano() / 100, first.Time().UnixNano() / 100)
+	assert.Equal(t, int64(0x0000AABBCCDDEEFF), first.Node())
+	assert.Equal(t, 0x3FFF, first.ClockSequence())
+
+	// same tick increments clock sequence
+
+	second, _ := gen.Next()
+	assert.Equal(t, first.Time100Nanos(), second.Time100Nanos())
+	assert.Equal(t, 0, second.ClockSequence())
+	assert.False(t, first.Equal(second))
+
+	// clock regression increments clock sequence
+
+	now = now.Add(-time.Second)
+	third, _ := gen.Next()
+	assert.Equal(t, 1, third.ClockSequence())
+
+	// clock goes forward keeps clock sequence
+
+	now = now.Add(time.Minute)
+	fourth, _ := gen.Next()
+	assert.Equal(t, 1, fourth.ClockSequence())
+
+}
+
+func TestTimebasedGeneratorOverflow(t *testing.T) {
+
+	gen := NewTimebasedGenerator(0xABCDEF, 100)
+	gen.SetOverflowPolicy(OverflowError)
+
+	now := time.Now()
+	gen.clock = func() time.Time { return now }
+
+	// all clock sequence values of the tick are distinct
+
+	seen := make(map[UUID]bool)
+	for i := 0; i <= clockSequenceBits; i = i + 1 {
+		uuid, err := gen.Next()
+		assert.NoError(t, err)
+		assert.False(t, seen[uuid])
+		seen[uuid] = true
+	}
+	assert.Equal(t, clockSequenceBits + 1, len(seen))
+
+	_, err := gen.Next()
+	assert.Equal(t, ErrorSequenceOverflow, err)
+
+	// next tick resets the interval
+
+	now = now.Add(100 * time.Nanosecond)
+	uuid, err := gen.Next()
+	assert.NoError(t, err)
+	assert.False(t, seen[uuid])
+
+	// spin waits for the next tick
+
+	gen = NewTimebasedGenerator(0xABCDEF, 100)
+	calls := 0
+	frozen := now
+	gen.clock = func() time.Time {
+		calls++
+		if calls > clockSequenceBits + 10 {
+			return frozen.Add(100 * time.Nanosecond)
+		}
+		return frozen
+	}
+
+	for i := 0; i <= clockSequenceBits; i = i + 1 {
+		gen.Next()
+	}
+	uuid, err = gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, frozen.Add(100 * time.Nanosecond).UnixNano() / 100, uuid.Time().UnixNano() / 100)
+
+}
+
+func TestUnixTimebasedGenerator(t *testing.T) {
+
+	gen := NewUnixTimebasedGenerator()
+
+	now := time.Unix(1700000000, 123456789)
+	gen.clock = func() time.Time { return now }
+
+	prev, err := gen.Next()
+	if err != nil {
+		t.Fatal("fail to generate uuid ", err)
+	}
+
+	assert.Equal(t, UnixTimebasedVer7, prev.Version())
+	assert.Equal(t, IETF, prev.Variant())
+	assert.Equal(t, uint64(now.UnixNano() / int64(time.Millisecond)), prev.mostSigBits >> 16)
+
+	for i := 0; i != 10000; i = i + 1 {
+		next, err := gen.Next()
+		if err != nil {
+			t.Fatal("fail to generate uuid ", err)
+		}
+		assert.True(t, ComparePostgres(prev, next) < 0, "monotonic failed")
+		assert.Equal(t, UnixTimebasedVer7, next.Version())
+		assert.Equal(t, IETF, next.Variant())
+		prev = next
+	}
+
+}
@@ -1,7 +1,17 @@
 module arpabet.pkg.is/timeuuid
 
-go 1.13
+go 1.23
 
 require github.com/pkg/errors v0.9.1
 
-require github.com/stretchr/testify v1.6.1
+require (
+	github.com/BurntSushi/toml v0.3.1
+	github.com/pelletier/go-toml v1.9.5
+	github.com/stretchr/testify v1.6.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
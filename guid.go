@@ -0,0 +1,74 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+)
+
+/**
+	Stores UUID in to 16 bytes of Microsoft GUID binary layout
+
+	Data1 (4 bytes), Data2 (2 bytes) and Data3 (2 bytes) are little-endian, Data4 (8 bytes) is stored as is,
+	the layout used by COM, MSSQL uniqueidentifier and NTFS
+ */
+
+func (this UUID) MarshalGUIDBinary() ([]byte, error) {
+	dst := make([]byte, 16)
+	err := this.MarshalGUIDBinaryTo(dst)
+	return dst, err
+}
+
+/**
+	Stores UUID in to slice of Microsoft GUID binary layout
+ */
+
+func (this UUID) MarshalGUIDBinaryTo(dst []byte) error {
+
+	if len(dst) < 16 {
+		return ErrorWrongLen
+	}
+
+	binary.LittleEndian.PutUint32(dst, uint32(this.mostSigBits >> 32))
+	binary.LittleEndian.PutUint16(dst[4:], uint16(this.mostSigBits >> 16))
+	binary.LittleEndian.PutUint16(dst[6:], uint16(this.mostSigBits))
+	binary.BigEndian.PutUint64(dst[8:], this.leastSigBits)
+
+	return nil
+}
+
+/**
+	Convert serialized 16 bytes of Microsoft GUID binary layout to UUID
+ */
+
+func (this *UUID) UnmarshalGUIDBinary(data []byte) error {
+
+	if len(data) < 16 {
+		return ErrorWrongLen
+	}
+
+	data1 := uint64(binary.LittleEndian.Uint32(data))
+	data2 := uint64(binary.LittleEndian.Uint16(data[4:]))
+	data3 := uint64(binary.LittleEndian.Uint16(data[6:]))
+
+	this.mostSigBits = data1 << 32 | data2 << 16 | data3
+	this.leastSigBits = binary.BigEndian.Uint64(data[8:])
+
+	return nil
+}
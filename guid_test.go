@@ -0,0 +1,47 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGUIDBinary(t *testing.T) {
+
+	uuid, err := Parse("00112233-4455-6677-8899-aabbccddeeff")
+	if err != nil {
+		t.Fatal("parse failed ", err)
+	}
+
+	// the same bytes as Guid.ToByteArray() in .NET
+
+	data, err := uuid.MarshalGUIDBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte { 0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff }, data)
+
+	var actual UUID
+	assert.NoError(t, actual.UnmarshalGUIDBinary(data))
+	assert.True(t, uuid.Equal(actual))
+
+	assert.Equal(t, ErrorWrongLen, uuid.MarshalGUIDBinaryTo(data[:15]))
+	assert.Equal(t, ErrorWrongLen, actual.UnmarshalGUIDBinary(data[:15]))
+
+}
@@ -0,0 +1,168 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+const hexDigits = "0123456789abcdef"
+
+/**
+	Lookup tables of the hex conversion, on the hot path of String and Parse
+ */
+
+var (
+	hexEncoding [512]byte
+	hexDecoding [256]byte
+)
+
+func init() {
+	for i := 0; i < 256; i++ {
+		hexEncoding[i * 2] = hexDigits[i >> 4]
+		hexEncoding[i * 2 + 1] = hexDigits[i & 0x0F]
+	}
+	for i := range hexDecoding {
+		hexDecoding[i] = 0xFF
+	}
+	for i := 0; i < len(hexDigits); i++ {
+		c := hexDigits[i]
+		hexDecoding[c] = byte(i)
+		if 'a' <= c && c <= 'f' {
+			hexDecoding[c + 'A' - 'a'] = byte(i)
+		}
+	}
+}
+
+/**
+	Encodes src in to 2 * len(src) lower case hex characters of dst
+ */
+
+func encodeHex(dst, src []byte) {
+	_ = dst[len(src) * 2 - 1]
+	for i, b := range src {
+		dst[i * 2] = hexEncoding[int(b) * 2]
+		dst[i * 2 + 1] = hexEncoding[int(b) * 2 + 1]
+	}
+}
+
+/**
+	Decodes len(dst) bytes from 2 * len(dst) hex characters of src
+
+	Returns position of the first non-hex character in src or -1
+ */
+
+func decodeHex(dst, src []byte) int {
+	_ = src[len(dst) * 2 - 1]
+	var invalid byte
+	for i := range dst {
+		hi, lo := hexDecoding[src[i * 2]], hexDecoding[src[i * 2 + 1]]
+		invalid |= hi | lo
+		dst[i] = hi << 4 | lo
+	}
+	if invalid & 0xF0 != 0 {
+		return invalidHexPos(src[:len(dst) * 2])
+	}
+	return -1
+}
+
+/**
+	Encodes UUID words in to 36 characters of dst with the fixed canonical layout, hyphens are not written
+
+	xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+ */
+
+func encodeCanonicalHex(dst []byte, mostSigBits, leastSigBits uint64) {
+	_ = dst[35]
+	putHex(dst[0:2], byte(mostSigBits >> 56))
+	putHex(dst[2:4], byte(mostSigBits >> 48))
+	putHex(dst[4:6], byte(mostSigBits >> 40))
+	putHex(dst[6:8], byte(mostSigBits >> 32))
+	putHex(dst[9:11], byte(mostSigBits >> 24))
+	putHex(dst[11:13], byte(mostSigBits >> 16))
+	putHex(dst[14:16], byte(mostSigBits >> 8))
+	putHex(dst[16:18], byte(mostSigBits))
+	putHex(dst[19:21], byte(leastSigBits >> 56))
+	putHex(dst[21:23], byte(leastSigBits >> 48))
+	putHex(dst[24:26], byte(leastSigBits >> 40))
+	putHex(dst[26:28], byte(leastSigBits >> 32))
+	putHex(dst[28:30], byte(leastSigBits >> 24))
+	putHex(dst[30:32], byte(leastSigBits >> 16))
+	putHex(dst[32:34], byte(leastSigBits >> 8))
+	putHex(dst[34:36], byte(leastSigBits))
+}
+
+/**
+	Decodes 16 bytes of UUID from 36 characters of src with the fixed canonical layout, hyphens are not verified
+
+	Returns position of the first non-hex character in src or -1
+ */
+
+func decodeCanonicalHex(data *[16]byte, src []byte) int {
+	_ = src[35]
+	var invalid byte
+	data[0] = getHex(src[0:2], &invalid)
+	data[1] = getHex(src[2:4], &invalid)
+	data[2] = getHex(src[4:6], &invalid)
+	data[3] = getHex(src[6:8], &invalid)
+	data[4] = getHex(src[9:11], &invalid)
+	data[5] = getHex(src[11:13], &invalid)
+	data[6] = getHex(src[14:16], &invalid)
+	data[7] = getHex(src[16:18], &invalid)
+	data[8] = getHex(src[19:21], &invalid)
+	data[9] = getHex(src[21:23], &invalid)
+	data[10] = getHex(src[24:26], &invalid)
+	data[11] = getHex(src[26:28], &invalid)
+	data[12] = getHex(src[28:30], &invalid)
+	data[13] = getHex(src[30:32], &invalid)
+	data[14] = getHex(src[32:34], &invalid)
+	data[15] = getHex(src[34:36], &invalid)
+	if invalid & 0xF0 == 0 {
+		return -1
+	}
+	for i, c := range src[:36] {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			continue
+		}
+		if hexDecoding[c] == 0xFF {
+			return i
+		}
+	}
+	return -1
+}
+
+func putHex(dst []byte, b byte) {
+	dst[0] = hexEncoding[int(b) * 2]
+	dst[1] = hexEncoding[int(b) * 2 + 1]
+}
+
+func getHex(src []byte, invalid *byte) byte {
+	hi, lo := hexDecoding[src[0]], hexDecoding[src[1]]
+	*invalid |= hi | lo
+	return hi << 4 | lo
+}
+
+/**
+	Gets position of the first non-hex character or -1
+ */
+
+func invalidHexPos(src []byte) int {
+	for i, c := range src {
+		if hexDecoding[c] == 0xFF {
+			return i
+		}
+	}
+	return -1
+}
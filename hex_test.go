@@ -0,0 +1,73 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHex(t *testing.T) {
+
+	for i := 0; i != 1000; i = i + 1 {
+
+		var data [16]byte
+		rand.Read(data[:])
+
+		expected := hex.EncodeToString(data[:])
+
+		var dst [32]byte
+		encodeHex(dst[:], data[:])
+		assert.Equal(t, expected, string(dst[:]))
+
+		var canonical [36]byte
+		encodeCanonicalHex(canonical[:], binary.BigEndian.Uint64(data[:8]), binary.BigEndian.Uint64(data[8:]))
+		canonical[8], canonical[13], canonical[18], canonical[23] = '-', '-', '-', '-'
+		assert.Equal(t, expected[:8] + "-" + expected[8:12] + "-" + expected[12:16] + "-" + expected[16:20] + "-" + expected[20:], string(canonical[:]))
+
+		var actual [16]byte
+		assert.Equal(t, -1, decodeHex(actual[:], dst[:]))
+		assert.Equal(t, data, actual)
+
+		actual = [16]byte{}
+		assert.Equal(t, -1, decodeCanonicalHex(&actual, canonical[:]))
+		assert.Equal(t, data, actual)
+
+		toUpperHex(canonical[:])
+		actual = [16]byte{}
+		assert.Equal(t, -1, decodeCanonicalHex(&actual, canonical[:]))
+		assert.Equal(t, data, actual)
+	}
+
+	// position of the first non-hex character
+
+	var data [16]byte
+	assert.Equal(t, 3, decodeHex(data[:], []byte("534g44a19bf13d20b71ecc4eb77c572z")))
+	assert.Equal(t, 31, decodeHex(data[:], []byte("534b44a19bf13d20b71ecc4eb77c572z")))
+	assert.Equal(t, 9, decodeCanonicalHex(&data, []byte("534b44a1-xbf1-3d20-b71e-cc4eb77c572f")))
+	assert.Equal(t, 35, decodeCanonicalHex(&data, []byte("534b44a1-9bf1-3d20-b71e-cc4eb77c572 ")))
+
+	// hyphens are not verified by the decoder
+	assert.Equal(t, -1, decodeCanonicalHex(&data, []byte("534b44a1x9bf1x3d20xb71excc4eb77c572f")))
+
+}
@@ -0,0 +1,113 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+/**
+	Gets iterator over UUIDs issued by generator
+
+	Iteration stops when the loop breaks, when the generator fails or when the context is done,
+	the error is yielded as the last element in the last two cases
+
+	for uuid, err := range timeuuid.All(ctx, gen) { ... }
+ */
+
+func All(ctx context.Context, gen Generator) iter.Seq2[UUID, error] {
+	return func(yield func(UUID, error) bool) {
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(Empty, err)
+				return
+			}
+			uuid, err := gen.Next()
+			if err != nil {
+				yield(Empty, err)
+				return
+			}
+			if !yield(uuid, nil) {
+				return
+			}
+		}
+	}
+}
+
+/**
+	Gets iterator over records of the streaming decoder
+
+	Iteration stops at the end of stream without error, decoding error is yielded as the last element
+ */
+
+func (this *Decoder) All() iter.Seq2[UUID, error] {
+	return func(yield func(UUID, error) bool) {
+		for {
+			uuid, err := this.Decode()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(Empty, err)
+				return
+			}
+			if !yield(uuid, nil) {
+				return
+			}
+		}
+	}
+}
+
+/**
+	Writes all UUIDs from the sequence to the streaming encoder and flushes it
+ */
+
+func (this *Encoder) EncodeAll(seq iter.Seq[UUID]) error {
+	for uuid := range seq {
+		if err := this.Encode(uuid); err != nil {
+			return err
+		}
+	}
+	return this.Flush()
+}
+
+/**
+	Drops errors from the sequence, iteration stops on the first error
+
+	Used to convert All results to iter.Seq[UUID] for the APIs accepting plain sequences, the error
+	could be received by the optional pointer
+ */
+
+func Values(seq iter.Seq2[UUID, error], errp *error) iter.Seq[UUID] {
+	return func(yield func(UUID) bool) {
+		for uuid, err := range seq {
+			if err != nil {
+				if errp != nil {
+					*errp = err
+				}
+				return
+			}
+			if !yield(uuid) {
+				return
+			}
+		}
+	}
+}
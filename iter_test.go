@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingGenerator struct {
+	left int
+}
+
+var errGeneratorFailed = errors.New("generator failed")
+
+func (this *failingGenerator) Next() (UUID, error) {
+	if this.left == 0 {
+		return Empty, errGeneratorFailed
+	}
+	this.left--
+	return NewUUID(RandomlyGeneratedVer4), nil
+}
+
+func TestIterators(t *testing.T) {
+
+	gen := NewUnixTimebasedGenerator()
+
+	var issued []UUID
+	for uuid, err := range All(context.Background(), gen) {
+		assert.NoError(t, err)
+		issued = append(issued, uuid)
+		if len(issued) == 10 {
+			break
+		}
+	}
+	assert.Equal(t, 10, len(issued))
+	assert.True(t, slices.IsSortedFunc(issued, ComparePostgres))
+
+	// context cancellation
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	count := 0
+	var lastErr error
+	for _, err := range All(ctx, gen) {
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		count++
+		if count == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, 3, count)
+	assert.Equal(t, context.Canceled, lastErr)
+
+	// generator failure
+
+	var err error
+	values := slices.Collect(Values(All(context.Background(), &failingGenerator{left: 2}), &err))
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, errGeneratorFailed, err)
+
+	// streaming
+
+	buf := new(bytes.Buffer)
+	assert.NoError(t, NewEncoder(buf, CompactFormat).EncodeAll(slices.Values(issued)))
+
+	var decoded []UUID
+	for uuid, err := range NewDecoder(buf, CompactFormat).All() {
+		assert.NoError(t, err)
+		decoded = append(decoded, uuid)
+	}
+	assert.Equal(t, issued, decoded)
+
+	err = nil
+	decoded = slices.Collect(Values(NewDecoder(strings.NewReader(issued[0].String() + "\nbad\n"), CanonicalFormat).All(), &err))
+	assert.Equal(t, issued[:1], decoded)
+	assert.Error(t, err)
+
+}
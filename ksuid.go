@@ -0,0 +1,200 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+	KSUID: 32-bit unix time in seconds since KSUID epoch + 128 bits of payload in the big-endian order
+ */
+
+type KSUID [20]byte
+
+const (
+	KSUIDEpoch = int64(1400000000)
+
+	ksuidStringLen = 27
+	ksuidSubSecondBits = 23
+	ksuidBase62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var (
+	ErrorKSUIDTimeOverflow = errors.New("time is out of KSUID range")
+)
+
+/**
+	Parses 27 characters of Base62 representation of KSUID
+ */
+
+func ParseKSUID(s string) (KSUID, error) {
+
+	var k KSUID
+
+	if len(s) != ksuidStringLen {
+		return k, ErrorWrongLen
+	}
+
+	// little-endian bytes of the value
+	var value [20]byte
+	for i := 0; i < len(s); i++ {
+
+		c := s[i]
+		var v int
+		switch {
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			v = int(c - 'A') + 10
+		case c >= 'a' && c <= 'z':
+			v = int(c - 'a') + 36
+		default:
+			return k, errors.Errorf("invalid base62 character %q at position %d", c, i)
+		}
+
+		carry := v
+		for j := range value {
+			carry += int(value[j]) * 62
+			value[j] = byte(carry)
+			carry >>= 8
+		}
+		if carry > 0 {
+			return k, errors.Errorf("base62 KSUID overflow: %q", s)
+		}
+	}
+
+	for i := range value {
+		k[19 - i] = value[i]
+	}
+	return k, nil
+}
+
+/**
+	Gets 27 characters of Base62 representation of KSUID padded with '0'
+ */
+
+func (k KSUID) String() string {
+
+	// big-endian digits in base 62
+	var digits [ksuidStringLen]byte
+	length := 0
+	for _, b := range k {
+		carry := int(b)
+		for i := 0; i < length; i++ {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 62)
+			carry /= 62
+		}
+		for carry > 0 {
+			digits[length] = byte(carry % 62)
+			length++
+			carry /= 62
+		}
+	}
+
+	var buf [ksuidStringLen]byte
+	for i := range buf {
+		buf[ksuidStringLen - 1 - i] = ksuidBase62Alphabet[digits[i]]
+	}
+	return string(buf[:])
+}
+
+/**
+	Gets unix time of KSUID in seconds
+ */
+
+func (k KSUID) UnixTime() int64 {
+	return int64(binary.BigEndian.Uint32(k[:4])) + KSUIDEpoch
+}
+
+/**
+	Gets time of KSUID in seconds precision
+ */
+
+func (k KSUID) Time() time.Time {
+	return time.Unix(k.UnixTime(), 0)
+}
+
+/**
+	Gets 16 bytes of KSUID payload
+ */
+
+func (k KSUID) Payload() []byte {
+	return k[4:]
+}
+
+/**
+	Converts KSUID to the Time-based UUID (version 1)
+
+	Seconds are stored in the timestamp, top 23 bits of payload are scaled to the
+	sub-second 100-nanosecond ticks of the timestamp, next 62 bits are stored in the counter,
+	so the conversion keeps KSUID ordering in MarshalSortableBinary
+
+	Low 43 bits of payload are truncated and could not be restored by ToKSUID
+ */
+
+func FromKSUID(k KSUID) UUID {
+
+	hi := binary.BigEndian.Uint64(k[4:12])
+	lo := binary.BigEndian.Uint64(k[12:])
+
+	payloadHigh := hi >> (64 - ksuidSubSecondBits)
+	subSecond := int64(payloadHigh * uint64(one100NanosInSecond) >> ksuidSubSecondBits)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTime100Nanos(k.UnixTime() * one100NanosInSecond + subSecond)
+	uuid.SetCounterUnsigned((hi << ksuidSubSecondBits | lo >> (64 - ksuidSubSecondBits)) >> 2)
+	return uuid
+}
+
+/**
+	Converts Time-based UUID (version 1) to KSUID
+
+	Truncated bits of payload are filled by zeros, so FromKSUID(uuid.ToKSUID()) == uuid
+	only for UUIDs made by FromKSUID, other UUIDs lose sub-second precision of the timestamp
+ */
+
+func (this UUID) ToKSUID() (KSUID, error) {
+
+	var k KSUID
+
+	if this.Version() != TimebasedVer1 {
+		return k, ErrorRequiredTimebasedUUID
+	}
+
+	unixTime100Nanos := this.UnixTime100Nanos()
+	seconds := unixTime100Nanos / one100NanosInSecond - KSUIDEpoch
+	if unixTime100Nanos < 0 || seconds < 0 || seconds > 0xFFFFFFFF {
+		return k, ErrorKSUIDTimeOverflow
+	}
+
+	// inverse of the scaling in FromKSUID rounded up, clamped at the end of the second that no KSUID maps to
+	subSecond := uint64(unixTime100Nanos % one100NanosInSecond)
+	payloadHigh := (subSecond << ksuidSubSecondBits + uint64(one100NanosInSecond) - 1) / uint64(one100NanosInSecond)
+	payloadHigh = min(payloadHigh, uint64(1) << ksuidSubSecondBits - 1)
+
+	counter := this.CounterUnsigned() << 2
+	binary.BigEndian.PutUint32(k[:4], uint32(seconds))
+	binary.BigEndian.PutUint64(k[4:12], payloadHigh << (64 - ksuidSubSecondBits) | counter >> ksuidSubSecondBits)
+	binary.BigEndian.PutUint64(k[12:], counter << (64 - ksuidSubSecondBits))
+	return k, nil
+}
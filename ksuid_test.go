@@ -0,0 +1,128 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKSUID(t *testing.T) {
+
+	// segmentio example
+
+	k, err := ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	assert.NoError(t, err)
+	assert.Equal(t, "0ujtsYcgvSTl8PAuAdqWYSMnLOv", k.String())
+	assert.Equal(t, int64(107608047) + KSUIDEpoch, k.UnixTime())
+	assert.Equal(t, "b5a1cd34b5f99d1154fb6853345c9735", hex.EncodeToString(k.Payload()))
+
+	uuid := FromKSUID(k)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, k.UnixTime(), uuid.Time().Unix())
+
+	// KSUID -> UUID -> KSUID keeps time and top 85 bits of payload
+
+	actual, err := uuid.ToKSUID()
+	assert.NoError(t, err)
+	assert.Equal(t, k[:14], actual[:14])
+	assert.Equal(t, k[14] & 0xF8, actual[14])
+	assert.Equal(t, make([]byte, 5), actual[15:])
+	assert.True(t, uuid.Equal(FromKSUID(actual)))
+
+	assert.Equal(t, "000000000000000000000000000", KSUID{}.String())
+	assert.Equal(t, "aWgEPTl1tmebfsQzFP4bxwgy80V", KSUID{ 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF }.String())
+
+	// KSUID ordering is kept in sortable binary
+
+	ksuids := make([]KSUID, 1000)
+	for i := range ksuids {
+		rand.Read(ksuids[i][:])
+		ksuids[i][0] = byte(rand.Intn(2))
+	}
+	sort.Slice(ksuids, func(i, j int) bool {
+		return bytes.Compare(ksuids[i][:], ksuids[j][:]) < 0
+	})
+	for i := 1; i < len(ksuids); i++ {
+		prev, _ := FromKSUID(ksuids[i-1]).MarshalSortableBinary()
+		next, _ := FromKSUID(ksuids[i]).MarshalSortableBinary()
+		assert.True(t, bytes.Compare(prev, next) <= 0)
+	}
+
+	// errors
+
+	_, err = ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLO")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLO-")
+	assert.Error(t, err)
+
+	_, err = ParseKSUID("zzzzzzzzzzzzzzzzzzzzzzzzzzz")
+	assert.Error(t, err)
+
+	_, err = NewUUID(RandomlyGeneratedVer4).ToKSUID()
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis((KSUIDEpoch - 1) * 1000)
+	_, err = uuid.ToKSUID()
+	assert.Equal(t, ErrorKSUIDTimeOverflow, err)
+
+}
+
+func TestKSUIDEndOfSecond(t *testing.T) {
+
+	seconds := KSUIDEpoch + 100
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTime100Nanos(seconds * one100NanosInSecond + one100NanosInSecond - 1)
+	uuid.SetCounter(rand.Int63())
+
+	last, err := uuid.ToKSUID()
+	assert.NoError(t, err)
+	assert.Equal(t, seconds, last.UnixTime())
+
+	// round trip keeps the second and the KSUID
+
+	actual := FromKSUID(last)
+	assert.Equal(t, seconds, actual.UnixTime100Nanos() / one100NanosInSecond)
+	again, err := actual.ToKSUID()
+	assert.NoError(t, err)
+	assert.Equal(t, last, again)
+
+	// ordering is kept against the previous tick and the next second
+
+	uuid.SetUnixTime100Nanos(seconds * one100NanosInSecond + one100NanosInSecond - 2)
+	prev, err := uuid.ToKSUID()
+	assert.NoError(t, err)
+	assert.True(t, bytes.Compare(prev[:], last[:]) <= 0)
+
+	uuid.SetUnixTime100Nanos((seconds + 1) * one100NanosInSecond)
+	uuid.SetMinCounter()
+	next, err := uuid.ToKSUID()
+	assert.NoError(t, err)
+	assert.True(t, bytes.Compare(last[:], next[:]) < 0)
+
+}
@@ -0,0 +1,163 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrorInvalidLayout = errors.New("invalid UUID layout")
+)
+
+/**
+	Checks that layout has exactly 32 hex digit placeholders and no dangling escape
+ */
+
+func checkLayout(layout string) error {
+	digits := 0
+	for i := 0; i < len(layout); i++ {
+		switch layout[i] {
+		case 'x', 'X':
+			digits++
+		case '\\':
+			i++
+			if i == len(layout) {
+				return errors.Wrapf(ErrorInvalidLayout, "dangling escape in %q", layout)
+			}
+		}
+	}
+	if digits != 32 {
+		return errors.Wrapf(ErrorInvalidLayout, "%d hex digits in %q", digits, layout)
+	}
+	return nil
+}
+
+/**
+	Appends UUID formatted by the layout to the slice
+
+	Layout is a template similar in spirit to time.Format: 'x' is the next hex digit in lower case,
+	'X' is the next hex digit in upper case, '\' escapes the next character and the rest of characters
+	are copied as is; layout must have exactly 32 digits, e.g.
+
+	XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX   upper case canonical form
+	xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx    four groups of 8 digits
+	ID:0\xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx  literal "ID:0x" prefix and 32 digits
+ */
+
+func (this UUID) AppendLayout(dst []byte, layout string) ([]byte, error) {
+
+	if err := checkLayout(layout); err != nil {
+		return dst, err
+	}
+
+	digit := 0
+	for i := 0; i < len(layout); i++ {
+		switch c := layout[i]; c {
+		case 'x', 'X':
+			word := this.mostSigBits
+			if digit >= 16 {
+				word = this.leastSigBits
+			}
+			d := hexDigits[word >> (60 - (digit % 16) * 4) & 0x0F]
+			if c == 'X' && d >= 'a' {
+				d = d - 'a' + 'A'
+			}
+			dst = append(dst, d)
+			digit++
+		case '\\':
+			i++
+			dst = append(dst, layout[i])
+		default:
+			dst = append(dst, c)
+		}
+	}
+
+	return dst, nil
+}
+
+/**
+	Formats UUID by the layout, see AppendLayout
+ */
+
+func (this UUID) FormatLayout(layout string) (string, error) {
+	dst, err := this.AppendLayout(make([]byte, 0, len(layout)), layout)
+	if err != nil {
+		return "", err
+	}
+	return string(dst), nil
+}
+
+/**
+	Parses UUID formatted by the layout, see AppendLayout
+
+	Literal characters must match exactly, hex digits are case-insensitive
+ */
+
+func ParseLayout(layout, s string) (uuid UUID, err error) {
+
+	if err := checkLayout(layout); err != nil {
+		return Empty, err
+	}
+
+	input := []byte(s)
+	pos := 0
+	digits := 0
+
+	for i := 0; i < len(layout); i++ {
+
+		if pos == len(input) {
+			return Empty, invalidLength(input)
+		}
+
+		c := layout[i]
+		switch c {
+
+		case 'x', 'X':
+			v := hexDecoding[input[pos]]
+			if v == 0xFF {
+				return Empty, invalidFormat(input, pos, fmt.Sprintf("invalid hex character %q", input[pos]))
+			}
+			if digits < 16 {
+				uuid.mostSigBits = uuid.mostSigBits << 4 | uint64(v)
+			} else {
+				uuid.leastSigBits = uuid.leastSigBits << 4 | uint64(v)
+			}
+			digits++
+			pos++
+			continue
+
+		case '\\':
+			i++
+			c = layout[i]
+		}
+
+		if input[pos] != c {
+			return Empty, invalidFormat(input, pos, fmt.Sprintf("expected %q", c))
+		}
+		pos++
+	}
+
+	if pos != len(input) {
+		return Empty, invalidLength(input)
+	}
+
+	return uuid, nil
+}
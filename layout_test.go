@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayout(t *testing.T) {
+
+	uuid, _ := Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	s, err := uuid.FormatLayout("XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX")
+	assert.NoError(t, err)
+	assert.Equal(t, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", s)
+
+	s, err = uuid.FormatLayout("xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx")
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810_9dad11d1_80b400c0_4fd430c8", s)
+
+	layout := `ID:0\x` + strings.Repeat("X", 32)
+	s, err = uuid.FormatLayout(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, "ID:0x6BA7B8109DAD11D180B400C04FD430C8", s)
+
+	dst, err := uuid.AppendLayout([]byte("id="), "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx")
+	assert.NoError(t, err)
+	assert.Equal(t, "id=6ba7b8109dad11d180b400c04fd430c8", string(dst))
+
+	// parser
+
+	actual, err := ParseLayout(layout, "ID:0x6BA7B8109DAD11D180B400C04FD430C8")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	actual, err = ParseLayout("XXXXXXXX_XXXXXXXX_XXXXXXXX_XXXXXXXX", "6ba7b810_9dad11d1_80b400c0_4fd430c8")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(actual))
+
+	actual, err = ParseLayout("xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", MaxUUID.String())
+	assert.NoError(t, err)
+	assert.True(t, MaxUUID.Equal(actual))
+
+	_, err = ParseLayout("xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx", "6ba7b810-9dad11d1_80b400c0_4fd430c8")
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+	var formatErr *InvalidFormatError
+	assert.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, 8, formatErr.Pos)
+
+	_, err = ParseLayout("xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx", "6ba7b810_9dad11d1_80b400c0_4fd430cz")
+	assert.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, 34, formatErr.Pos)
+
+	_, err = ParseLayout("xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx", "6ba7b810_9dad11d1_80b400c0_4fd430c")
+	assert.True(t, errors.Is(err, ErrorInvalidLength))
+
+	_, err = ParseLayout("xxxxxxxx_xxxxxxxx_xxxxxxxx_xxxxxxxx", "6ba7b810_9dad11d1_80b400c0_4fd430c8_")
+	assert.True(t, errors.Is(err, ErrorInvalidLength))
+
+	// invalid layouts
+
+	_, err = uuid.FormatLayout("xxxx")
+	assert.True(t, errors.Is(err, ErrorInvalidLayout))
+
+	_, err = uuid.FormatLayout(strings.Repeat("x", 32) + `\`)
+	assert.True(t, errors.Is(err, ErrorInvalidLayout))
+
+	_, err = ParseLayout(strings.Repeat("x", 33), strings.Repeat("0", 33))
+	assert.True(t, errors.Is(err, ErrorInvalidLayout))
+
+}
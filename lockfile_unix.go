@@ -0,0 +1,101 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+//go:build unix
+
+package timeuuid
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+)
+
+/**
+	Locker backed by flock(2) on the file, the lock is released by the kernel when the process exits,
+	so slots of crashed processes are claimed again
+ */
+
+type FileLocker struct {
+	mutex sync.Mutex
+	path  string
+	file  *os.File
+}
+
+/**
+	Creates file locker, the file is created on the first TryLock
+ */
+
+func NewFileLocker(path string) *FileLocker {
+	return &FileLocker{ path: path }
+}
+
+/**
+	Creates n file lockers named clockseq-<slot>.lock in the directory for ClaimClockSequenceSlot
+ */
+
+func NewFileLockers(dir string, n int) []Locker {
+	lockers := make([]Locker, n)
+	for i := range lockers {
+		lockers[i] = NewFileLocker(filepath.Join(dir, fmt.Sprintf("clockseq-%d.lock", i)))
+	}
+	return lockers
+}
+
+func (this *FileLocker) TryLock() (bool, error) {
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.file != nil {
+		return false, nil
+	}
+
+	file, err := os.OpenFile(this.path, os.O_RDWR | os.O_CREATE, 0644)
+	if err != nil {
+		return false, err
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX | syscall.LOCK_NB); err != nil {
+		file.Close()
+		if err == syscall.EWOULDBLOCK {
+			return false, nil
+		}
+		return false, &os.PathError{ Op: "flock", Path: this.path, Err: err }
+	}
+
+	this.file = file
+	return true, nil
+}
+
+func (this *FileLocker) Unlock() error {
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	if this.file == nil {
+		return nil
+	}
+
+	// closing the file releases the lock
+	err := this.file.Close()
+	this.file = nil
+	return err
+}
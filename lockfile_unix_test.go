@@ -0,0 +1,62 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+//go:build unix
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileLocker(t *testing.T) {
+
+	dir := t.TempDir()
+
+	// flock conflicts between open files of the same process as between processes
+
+	mainProcess := NewFileLockers(dir, 2)
+	sidecar := NewFileLockers(dir, 2)
+
+	first, err := ClaimClockSequenceSlot(mainProcess)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, first.Slot())
+
+	second, err := ClaimClockSequenceSlot(sidecar)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x2000, second.Slot())
+
+	_, err = ClaimClockSequenceSlot(NewFileLockers(dir, 2))
+	assert.Equal(t, ErrorNoFreeSlot, err)
+
+	assert.NoError(t, first.Release())
+	assert.NoError(t, first.Release())
+
+	again, err := ClaimClockSequenceSlot(NewFileLockers(dir, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, again.Slot())
+
+	assert.NoError(t, second.Release())
+	assert.NoError(t, again.Release())
+
+	_, err = NewFileLocker(dir + "/missing/clockseq.lock").TryLock()
+	assert.Error(t, err)
+
+}
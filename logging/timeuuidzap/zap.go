@@ -0,0 +1,90 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuidzap
+
+import (
+	"sync"
+
+	"arpabet.pkg.is/timeuuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new([36]byte)
+	},
+}
+
+/**
+	Constructs a field with the canonical string of UUID
+ */
+
+func ID(key string, uuid timeuuid.UUID) zap.Field {
+	return zap.String(key, uuid.String())
+}
+
+/**
+	Constructs a field with the slice of UUIDs as the array of canonical strings
+ */
+
+func IDs(key string, uuids []timeuuid.UUID) zap.Field {
+	return zap.Array(key, Array(uuids))
+}
+
+/**
+	Adds UUID to the object encoder without allocations
+
+	Used inside zapcore.ObjectMarshaler implementations on the high-volume paths
+ */
+
+func AddTo(enc zapcore.ObjectEncoder, key string, uuid timeuuid.UUID) {
+	buf := bufferPool.Get().(*[36]byte)
+	uuid.MarshalTextTo(buf[:])
+	enc.AddByteString(key, buf[:])
+	bufferPool.Put(buf)
+}
+
+/**
+	Appends UUID to the array encoder without allocations
+ */
+
+func AppendTo(enc zapcore.ArrayEncoder, uuid timeuuid.UUID) {
+	buf := bufferPool.Get().(*[36]byte)
+	uuid.MarshalTextTo(buf[:])
+	enc.AppendByteString(buf[:])
+	bufferPool.Put(buf)
+}
+
+/**
+	Slice of UUIDs that implements zapcore.ArrayMarshaler
+ */
+
+type Array []timeuuid.UUID
+
+/**
+	MarshalLogArray implements the zapcore.ArrayMarshaler interface.
+ */
+
+func (a Array) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, uuid := range a {
+		AppendTo(enc, uuid)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuidzap
+
+import (
+	"bytes"
+	"testing"
+
+	"arpabet.pkg.is/timeuuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type request struct {
+	id timeuuid.UUID
+}
+
+func (r *request) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	AddTo(enc, "request_id", r.id)
+	return nil
+}
+
+type discard struct {
+	bytes.Buffer
+}
+
+func (d *discard) Sync() error {
+	return nil
+}
+
+func TestZap(t *testing.T) {
+
+	uuid, err := timeuuid.NameUUIDFromBytes([]byte("alex"), timeuuid.NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	out := new(discard)
+	encoderConfig := zapcore.EncoderConfig{MessageKey: "msg"}
+	logger := zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), out, zapcore.InfoLevel))
+
+	logger.Info("handled", ID("request_id", uuid), IDs("peers", []timeuuid.UUID{uuid, timeuuid.Empty}))
+	assert.Equal(t, `{"msg":"handled","request_id":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f","peers":["534b44a1-9bf1-3d20-b71e-cc4eb77c572f","00000000-0000-0000-0000-000000000000"]}` + "\n", out.String())
+
+	out.Reset()
+	req := &request{id: uuid}
+	logger.Info("handled", zap.Object("req", req))
+	assert.Equal(t, `{"msg":"handled","req":{"request_id":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f"}}` + "\n", out.String())
+
+	// zap itself allocates variadic fields, UUID must not add more
+
+	baseline := testing.AllocsPerRun(100, func() {
+		out.Reset()
+		logger.Info("handled", zap.Int64("request_id", 1))
+	})
+
+	allocs := testing.AllocsPerRun(100, func() {
+		out.Reset()
+		logger.Info("handled", zap.Object("req", req))
+	})
+	assert.Equal(t, baseline, allocs)
+
+}
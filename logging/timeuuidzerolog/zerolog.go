@@ -0,0 +1,70 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuidzerolog
+
+import (
+	"arpabet.pkg.is/timeuuid"
+	"github.com/rs/zerolog"
+)
+
+/**
+	Adds UUID as the canonical string to the event without allocations
+ */
+
+func ID(e *zerolog.Event, key string, uuid timeuuid.UUID) *zerolog.Event {
+	var buf [36]byte
+	uuid.MarshalTextTo(buf[:])
+	return e.Bytes(key, buf[:])
+}
+
+/**
+	Adds UUID as the canonical string to the logger context
+ */
+
+func Context(c zerolog.Context, key string, uuid timeuuid.UUID) zerolog.Context {
+	var buf [36]byte
+	uuid.MarshalTextTo(buf[:])
+	return c.Bytes(key, buf[:])
+}
+
+/**
+	Adds slice of UUIDs as the array of canonical strings to the event
+ */
+
+func IDs(e *zerolog.Event, key string, uuids []timeuuid.UUID) *zerolog.Event {
+	return e.Array(key, Array(uuids))
+}
+
+/**
+	Slice of UUIDs that implements zerolog.LogArrayMarshaler
+ */
+
+type Array []timeuuid.UUID
+
+/**
+	MarshalZerologArray implements the zerolog.LogArrayMarshaler interface.
+ */
+
+func (a Array) MarshalZerologArray(arr *zerolog.Array) {
+	var buf [36]byte
+	for _, uuid := range a {
+		uuid.MarshalTextTo(buf[:])
+		arr.Bytes(buf[:])
+	}
+}
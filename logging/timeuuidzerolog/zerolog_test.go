@@ -0,0 +1,58 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuidzerolog
+
+import (
+	"bytes"
+	"testing"
+
+	"arpabet.pkg.is/timeuuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZerolog(t *testing.T) {
+
+	uuid, err := timeuuid.NameUUIDFromBytes([]byte("alex"), timeuuid.NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	out := new(bytes.Buffer)
+	logger := zerolog.New(out)
+
+	ID(logger.Info(), "request_id", uuid).Msg("handled")
+	assert.Equal(t, `{"level":"info","request_id":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f","message":"handled"}` + "\n", out.String())
+
+	out.Reset()
+	IDs(logger.Info(), "peers", []timeuuid.UUID{uuid, timeuuid.Empty}).Msg("handled")
+	assert.Equal(t, `{"level":"info","peers":["534b44a1-9bf1-3d20-b71e-cc4eb77c572f","00000000-0000-0000-0000-000000000000"],"message":"handled"}` + "\n", out.String())
+
+	out.Reset()
+	child := Context(logger.With(), "request_id", uuid).Logger()
+	child.Info().Msg("handled")
+	assert.Equal(t, `{"level":"info","request_id":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f","message":"handled"}` + "\n", out.String())
+
+	allocs := testing.AllocsPerRun(100, func() {
+		out.Reset()
+		ID(logger.Info(), "request_id", uuid).Msg("handled")
+	})
+	assert.Equal(t, float64(0), allocs)
+
+}
@@ -0,0 +1,130 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+/**
+	Merges time-ordered UUID sources in to a single stream in the CompareByTime order without duplicates
+
+	Each source must be ordered by CompareByTime, the merge pulls sources lazily and stops them when the loop breaks
+
+	for uuid := range timeuuid.Merge(a, b, c) { ... }
+ */
+
+func Merge(seqs ...iter.Seq[UUID]) iter.Seq[UUID] {
+	return MergeFunc(UUID.CompareByTime, seqs...)
+}
+
+/**
+	Merges sorted UUID slices in to a single stream in the CompareByTime order without duplicates
+ */
+
+func MergeSlices(sources ...[]UUID) iter.Seq[UUID] {
+	seqs := make([]iter.Seq[UUID], len(sources))
+	for i, source := range sources {
+		seqs[i] = slices.Values(source)
+	}
+	return Merge(seqs...)
+}
+
+/**
+	Merges UUID sources ordered by the comparator in to a single ordered stream without duplicates
+ */
+
+func MergeFunc(cmp func(a, b UUID) int, seqs ...iter.Seq[UUID]) iter.Seq[UUID] {
+	return func(yield func(UUID) bool) {
+
+		h := &mergeHeap{ cmp: cmp }
+		defer func() {
+			for _, e := range h.entries {
+				e.stop()
+			}
+		}()
+
+		for _, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			if uuid, ok := next(); ok {
+				h.entries = append(h.entries, &mergeEntry{ uuid: uuid, next: next, stop: stop })
+			} else {
+				stop()
+			}
+		}
+		heap.Init(h)
+
+		var last UUID
+		first := true
+		for len(h.entries) > 0 {
+
+			e := h.entries[0]
+			if first || e.uuid != last {
+				if !yield(e.uuid) {
+					return
+				}
+				last, first = e.uuid, false
+			}
+
+			if uuid, ok := e.next(); ok {
+				e.uuid = uuid
+				heap.Fix(h, 0)
+			} else {
+				e.stop()
+				heap.Pop(h)
+			}
+		}
+	}
+}
+
+type mergeEntry struct {
+	uuid UUID
+	next func() (UUID, bool)
+	stop func()
+}
+
+type mergeHeap struct {
+	cmp     func(a, b UUID) int
+	entries []*mergeEntry
+}
+
+func (this *mergeHeap) Len() int {
+	return len(this.entries)
+}
+
+func (this *mergeHeap) Less(i, j int) bool {
+	return this.cmp(this.entries[i].uuid, this.entries[j].uuid) < 0
+}
+
+func (this *mergeHeap) Swap(i, j int) {
+	this.entries[i], this.entries[j] = this.entries[j], this.entries[i]
+}
+
+func (this *mergeHeap) Push(x any) {
+	this.entries = append(this.entries, x.(*mergeEntry))
+}
+
+func (this *mergeHeap) Pop() any {
+	n := len(this.entries)
+	e := this.entries[n-1]
+	this.entries = this.entries[:n-1]
+	return e
+}
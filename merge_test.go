@@ -0,0 +1,85 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+
+	now := time.Now()
+
+	var all []UUID
+	sources := make([][]UUID, 5)
+	for i := range sources {
+		for j := 0; j != 100; j = j + 1 {
+			uuid := NewUUID(TimebasedVer1)
+			uuid.SetTime(now.Add(time.Duration(rand.Int63n(1000)) * time.Microsecond))
+			uuid.SetCounter(rand.Int63n(3))
+			sources[i] = append(sources[i], uuid)
+		}
+		SortByTime(sources[i])
+		all = append(all, sources[i]...)
+	}
+
+	SortByTime(all)
+	expected := Dedup(all)
+
+	actual := slices.Collect(MergeSlices(sources...))
+	assert.Equal(t, expected, actual)
+
+	// early break stops the sources
+
+	stopped := 0
+	counting := func(source []UUID) func(func(UUID) bool) {
+		return func(yield func(UUID) bool) {
+			defer func() { stopped++ }()
+			for _, uuid := range source {
+				if !yield(uuid) {
+					return
+				}
+			}
+		}
+	}
+
+	n := 0
+	for range Merge(counting(sources[0]), counting(sources[1]), counting(nil)) {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	assert.Equal(t, 10, n)
+	assert.Equal(t, 3, stopped)
+
+	// custom order
+
+	a, b := CreateUUID(1, 1), CreateUUID(2, 2)
+	actual = slices.Collect(MergeFunc(UUID.Compare, slices.Values([]UUID{ a, b }), slices.Values([]UUID{ a })))
+	assert.Equal(t, []UUID{ a, b }, actual)
+
+	assert.Empty(t, slices.Collect(Merge()))
+
+}
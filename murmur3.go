@@ -0,0 +1,96 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math"
+	"math/bits"
+)
+
+const (
+	murmur3C1 = uint64(0x87c37b91114253d5)
+	murmur3C2 = uint64(0x4cf5ad432745937f)
+)
+
+/**
+	Gets Cassandra Murmur3Partitioner token of UUID serialized in 16 bytes
+
+	Token is the first 64 bits of MurmurHash3 x64 128 with zero seed, Long.MIN_VALUE is mapped to Long.MAX_VALUE
+ */
+
+func (this UUID) Token() int64 {
+	token := int64(murmur3H1(this.mostSigBits, this.leastSigBits))
+	if token == math.MinInt64 {
+		return math.MaxInt64
+	}
+	return token
+}
+
+/**
+	MurmurHash3 x64 128 of the single 16 bytes block given as two big-endian words
+ */
+
+func murmur3H1(hi, lo uint64) uint64 {
+
+	// block is read in the little-endian order
+	k1 := bits.ReverseBytes64(hi)
+	k2 := bits.ReverseBytes64(lo)
+
+	var h1, h2 uint64
+
+	k1 *= murmur3C1
+	k1 = bits.RotateLeft64(k1, 31)
+	k1 *= murmur3C2
+	h1 ^= k1
+
+	h1 = bits.RotateLeft64(h1, 27)
+	h1 += h2
+	h1 = h1 * 5 + 0x52dce729
+
+	k2 *= murmur3C2
+	k2 = bits.RotateLeft64(k2, 33)
+	k2 *= murmur3C1
+	h2 ^= k2
+
+	h2 = bits.RotateLeft64(h2, 31)
+	h2 += h1
+	h2 = h2 * 5 + 0x38495ab5
+
+	// finalization with length 16
+	h1 ^= 16
+	h2 ^= 16
+
+	h1 += h2
+	h2 += h1
+
+	h1 = murmur3Mix(h1)
+	h2 = murmur3Mix(h2)
+
+	h1 += h2
+	return h1
+}
+
+func murmur3Mix(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
@@ -0,0 +1,42 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken(t *testing.T) {
+
+	// tokens computed by Cassandra Murmur3Partitioner
+
+	for s, token := range map[string]int64{
+		"00000000-0000-0000-0000-000000000000": 5457549051747178710,
+		"4bf92f35-77b3-4da6-a3ce-929d0e0e4736": -6408810611889564321,
+		"ffffffff-ffff-ffff-ffff-ffffffffffff": -2824192546314762522,
+		"5d9e8c20-53a4-11ee-be56-0242ac120002": 1487220388613577395,
+	} {
+		uuid, err := Parse(s)
+		assert.NoError(t, err)
+		assert.Equal(t, token, uuid.Token(), s)
+	}
+
+}
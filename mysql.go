@@ -0,0 +1,74 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+)
+
+/**
+	Stores UUID in to 16 bytes of MySQL UUID_TO_BIN(uuid, 1) layout
+
+	time_high_and_version and time_mid are swapped with time_low, so time-based UUIDs are
+	stored in the index-friendly order: UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1) = 1026baba6ccd780c95645b8c656024db
+ */
+
+func (this UUID) MarshalMySQLBinary() ([]byte, error) {
+	dst := make([]byte, 16)
+	err := this.MarshalMySQLBinaryTo(dst)
+	return dst, err
+}
+
+/**
+	Stores UUID in to slice of MySQL UUID_TO_BIN(uuid, 1) layout
+ */
+
+func (this UUID) MarshalMySQLBinaryTo(dst []byte) error {
+
+	if len(dst) < 16 {
+		return ErrorWrongLen
+	}
+
+	binary.BigEndian.PutUint16(dst, uint16(this.mostSigBits))
+	binary.BigEndian.PutUint16(dst[2:], uint16(this.mostSigBits >> 16))
+	binary.BigEndian.PutUint32(dst[4:], uint32(this.mostSigBits >> 32))
+	binary.BigEndian.PutUint64(dst[8:], this.leastSigBits)
+
+	return nil
+}
+
+/**
+	Convert serialized 16 bytes of MySQL UUID_TO_BIN(uuid, 1) layout to UUID, the same as BIN_TO_UUID(data, 1)
+ */
+
+func (this *UUID) UnmarshalMySQLBinary(data []byte) error {
+
+	if len(data) < 16 {
+		return ErrorWrongLen
+	}
+
+	timeHigh := uint64(binary.BigEndian.Uint16(data))
+	timeMid := uint64(binary.BigEndian.Uint16(data[2:]))
+	timeLow := uint64(binary.BigEndian.Uint32(data[4:]))
+
+	this.mostSigBits = timeLow << 32 | timeMid << 16 | timeHigh
+	this.leastSigBits = binary.BigEndian.Uint64(data[8:])
+
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLBinary(t *testing.T) {
+
+	uuid, err := Parse("6ccd780c-baba-1026-9564-5b8c656024db")
+	if err != nil {
+		t.Fatal("parse failed ", err)
+	}
+
+	data, err := uuid.MarshalMySQLBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, "1026baba6ccd780c95645b8c656024db", hex.EncodeToString(data))
+
+	var actual UUID
+	assert.NoError(t, actual.UnmarshalMySQLBinary(data))
+	assert.True(t, uuid.Equal(actual))
+
+	assert.Equal(t, ErrorWrongLen, uuid.MarshalMySQLBinaryTo(data[:15]))
+	assert.Equal(t, ErrorWrongLen, actual.UnmarshalMySQLBinary(data[:15]))
+
+}
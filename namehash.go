@@ -0,0 +1,118 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"github.com/pkg/errors"
+	"hash"
+	"io"
+)
+
+/**
+	Incremental builder of name-based UUID, the name is written in chunks through io.Writer
+
+	Used to derive content-addressed UUIDs of large files without loading them in to memory,
+	write the 16 bytes of the name space UUID first to get the value of NameUUID
+ */
+
+type NameHasher struct {
+	hash    hash.Hash
+	version Version
+}
+
+/**
+	Creates new name hasher for NamebasedVer3 (MD5), NamebasedVer5 (SHA-1) or CustomVer8 (SHA-256)
+ */
+
+func NewNameHasher(version Version) (*NameHasher, error) {
+	h, err := newNameHash(version)
+	if err != nil {
+		return nil, err
+	}
+	return &NameHasher{ hash: h, version: version }, nil
+}
+
+/**
+	Adds the chunk of the name to the digest, never returns an error
+ */
+
+func (this *NameHasher) Write(p []byte) (int, error) {
+	return this.hash.Write(p)
+}
+
+/**
+	Gets name-based UUID of the name written so far, does not change the state of the hasher
+ */
+
+func (this *NameHasher) UUID() UUID {
+	return nameUUIDFromDigest(this.hash.Sum(nil), this.version)
+}
+
+/**
+	Resets hasher to the empty name
+ */
+
+func (this *NameHasher) Reset() {
+	this.hash.Reset()
+}
+
+/**
+	Creates UUID based on digest of the name read from the reader till EOF
+ */
+
+func NameUUIDFromReader(r io.Reader, version Version) (UUID, error) {
+	hasher, err := NewNameHasher(version)
+	if err != nil {
+		return Empty, err
+	}
+	if _, err := io.Copy(hasher, r); err != nil {
+		return Empty, err
+	}
+	return hasher.UUID(), nil
+}
+
+func newNameHash(version Version) (hash.Hash, error) {
+	switch version {
+	case NamebasedVer3:
+		return md5.New(), nil
+	case NamebasedVer5:
+		return sha1.New(), nil
+	case CustomVer8:
+		// RFC 9562 B.2: first 128 bits of SHA-256 digest
+		return sha256.New(), nil
+	default:
+		return nil, errors.Errorf("unknown namebased version: %q", version)
+	}
+}
+
+func nameUUIDFromDigest(digest []byte, version Version) UUID {
+
+	var data [16]byte
+	copy(data[:], digest)
+
+	data[6] &= 0x0f;                   /* clear version        */
+	data[6] |= byte(version) << 4;     /* set to version       */
+	data[8] &= 0x3f;                   /* clear variant        */
+	data[8] |= 0x80;                   /* set to IETF variant  */
+
+	return FromArray(data)
+}
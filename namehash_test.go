@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameHasher(t *testing.T) {
+
+	name := bytes.Repeat([]byte("timeuuid"), 100000)
+
+	for _, version := range []Version{ NamebasedVer3, NamebasedVer5, CustomVer8 } {
+
+		expected, err := NameUUIDFromBytes(name, version)
+		assert.NoError(t, err)
+		assert.Equal(t, version, expected.Version())
+		assert.Equal(t, IETF, expected.Variant())
+
+		actual, err := NameUUIDFromReader(bytes.NewReader(name), version)
+		assert.NoError(t, err)
+		assert.True(t, expected.Equal(actual))
+
+		// chunks
+
+		hasher, err := NewNameHasher(version)
+		assert.NoError(t, err)
+		for i := 0; i < len(name); i += 4096 {
+			hasher.Write(name[i:min(i + 4096, len(name))])
+		}
+		assert.True(t, expected.Equal(hasher.UUID()))
+		assert.True(t, expected.Equal(hasher.UUID()))
+
+		hasher.Reset()
+		empty, _ := NameUUIDFromBytes(nil, version)
+		assert.True(t, empty.Equal(hasher.UUID()))
+	}
+
+	// name space written first gives NameUUID
+
+	hasher, _ := NewNameHasher(NamebasedVer5)
+	data := NamespaceDNS.Array()
+	hasher.Write(data[:])
+	io.WriteString(hasher, "www.example.com")
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", hasher.UUID().String())
+
+	// errors
+
+	_, err := NewNameHasher(TimebasedVer1)
+	assert.Error(t, err)
+
+	_, err = NameUUIDFromReader(strings.NewReader("alex"), RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+	_, err = NameUUIDFromReader(&failingReader{ failures: 1 }, NamebasedVer5)
+	assert.Equal(t, errEntropy, err)
+
+}
@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+/**
+	Name space identifiers of RFC 4122 Appendix C
+ */
+
+var (
+	NamespaceDNS  = UUID{0x6ba7b8109dad11d1, 0x80b400c04fd430c8}
+	NamespaceURL  = UUID{0x6ba7b8119dad11d1, 0x80b400c04fd430c8}
+	NamespaceOID  = UUID{0x6ba7b8129dad11d1, 0x80b400c04fd430c8}
+	NamespaceX500 = UUID{0x6ba7b8149dad11d1, 0x80b400c04fd430c8}
+)
+
+/**
+	Creates name-based UUID of the name in the name space as described in RFC 4122 4.3
+
+	Digest is computed over the 16 bytes of the name space UUID followed by the name,
+	so values are compatible with other UUID libraries; supported versions are
+	NamebasedVer3 (MD5), NamebasedVer5 (SHA-1) and CustomVer8 (SHA-256 of RFC 9562 B.2)
+ */
+
+func NameUUID(namespace UUID, name []byte, version Version) (uuid UUID, err error) {
+	data := make([]byte, 16 + len(name))
+	namespace.MarshalBinaryTo(data)
+	copy(data[16:], name)
+	err = uuid.SetName(data, version)
+	return uuid, err
+}
+
+/**
+	Creates name-based UUID (version 3) of the name in the name space
+ */
+
+func NewV3(namespace UUID, name string) UUID {
+	uuid, _ := NameUUID(namespace, []byte(name), NamebasedVer3)
+	return uuid
+}
+
+/**
+	Creates name-based UUID (version 5) of the name in the name space
+ */
+
+func NewV5(namespace UUID, name string) UUID {
+	uuid, _ := NameUUID(namespace, []byte(name), NamebasedVer5)
+	return uuid
+}
+
+/**
+	Creates name-based UUID (version 8) of the name in the name space with SHA-256 digest
+
+	Layout follows RFC 9562 B.2: the first 128 bits of SHA-256 over the name space UUID and the name,
+	with 4 bits replaced by the version and 2 bits by the IETF variant
+ */
+
+func NewSHA256(namespace UUID, name string) UUID {
+	uuid, _ := NameUUID(namespace, []byte(name), CustomVer8)
+	return uuid
+}
+
+/**
+	Derives name-based UUID (version 5) of the path of segments under the parent UUID
+
+	Every segment is hashed in the name space of the UUID derived for the previous segments,
+	so DeriveUUID(tenant, project, document) == NewV5(NewV5(tenant, project), document) and
+	segment boundaries are never ambiguous; parent is returned as is for the empty path
+ */
+
+func DeriveUUID(parent UUID, segments ...[]byte) UUID {
+	for _, segment := range segments {
+		parent, _ = NameUUID(parent, segment, NamebasedVer5)
+	}
+	return parent
+}
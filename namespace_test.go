@@ -0,0 +1,81 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespace(t *testing.T) {
+
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", NamespaceDNS.String())
+	assert.Equal(t, "6ba7b811-9dad-11d1-80b4-00c04fd430c8", NamespaceURL.String())
+	assert.Equal(t, "6ba7b812-9dad-11d1-80b4-00c04fd430c8", NamespaceOID.String())
+	assert.Equal(t, "6ba7b814-9dad-11d1-80b4-00c04fd430c8", NamespaceX500.String())
+
+	// the same values as python uuid.uuid3 and uuid.uuid5
+
+	uuid, err := NameUUID(NamespaceDNS, []byte("www.example.com"), NamebasedVer5)
+	assert.NoError(t, err)
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", uuid.String())
+	assert.Equal(t, NamebasedVer5, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+
+	uuid, err = NameUUID(NamespaceDNS, []byte("www.example.com"), NamebasedVer3)
+	assert.NoError(t, err)
+	assert.Equal(t, "5df41881-3aed-3515-88a7-2f4a814cf09e", uuid.String())
+	assert.Equal(t, NamebasedVer3, uuid.Version())
+
+	assert.Equal(t, "2ed6657d-e927-568b-95e1-2665a8aea6a2", NewV5(NamespaceDNS, "www.example.com").String())
+	assert.Equal(t, "5df41881-3aed-3515-88a7-2f4a814cf09e", NewV3(NamespaceDNS, "www.example.com").String())
+	assert.Equal(t, "dd2c1780-811a-5296-81c5-178a0ef488bc", NewV5(NamespaceURL, "https://example.com/").String())
+
+	// RFC 9562 B.2 example of SHA-256 name-based UUID
+
+	uuid, err = NameUUID(NamespaceDNS, []byte("www.example.com"), CustomVer8)
+	assert.NoError(t, err)
+	assert.Equal(t, "5c146b14-3c52-8afd-938a-375d0df1fbf6", uuid.String())
+	assert.Equal(t, CustomVer8, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.True(t, uuid.Equal(NewSHA256(NamespaceDNS, "www.example.com")))
+
+	uuid = NewV5(NamespaceDNS, "www.example.com")
+
+	// name space changes the value
+
+	legacy, _ := NameUUIDFromBytes([]byte("www.example.com"), NamebasedVer5)
+	assert.False(t, legacy.Equal(uuid))
+
+	_, err = NameUUID(NamespaceDNS, []byte("www.example.com"), RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+	// hierarchical derivation
+
+	tenant := NewV5(NamespaceURL, "https://example.com/tenants/acme")
+	document := DeriveUUID(tenant, []byte("project"), []byte("document"))
+	assert.True(t, document.Equal(NewV5(NewV5(tenant, "project"), "document")))
+	assert.Equal(t, NamebasedVer5, document.Version())
+	assert.True(t, document.Equal(DeriveUUID(tenant, []byte("project"), []byte("document"))))
+	assert.False(t, document.Equal(DeriveUUID(tenant, []byte("projectdocument"))))
+	assert.False(t, document.Equal(DeriveUUID(tenant, []byte("proj"), []byte("ectdocument"))))
+	assert.True(t, tenant.Equal(DeriveUUID(tenant)))
+
+}
@@ -0,0 +1,186 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+
+	/**
+		Maximum 48-bit node of Time-based UUID
+	 */
+
+	MaxNode = int64(0xFFFFFFFFFFFF)
+
+	/**
+		Environment variable with the Kubernetes pod UID, set by the Downward API:
+
+		env:
+		- name: POD_UID
+		  valueFrom:
+		    fieldRef:
+		      fieldPath: metadata.uid
+	 */
+
+	PodUIDEnv = "POD_UID"
+
+	/**
+		File with the Kubernetes pod UID, mounted by the Downward API volume with the item path "uid"
+		for the field metadata.uid
+	 */
+
+	PodUIDFile = "/etc/podinfo/uid"
+
+	/**
+		Control group file of the process holding the 64-character container ID assigned by the runtime
+	 */
+
+	containerCgroupFile = "/proc/self/cgroup"
+)
+
+var (
+	ErrorNoPodIdentity = errors.New("no pod UID or container ID")
+	ErrorNodeNotSet = errors.New("node environment variable is not set")
+	ErrorInvalidNode = errors.New("invalid node")
+)
+
+/**
+	Derives stable 48-bit node of Time-based UUID from the host name
+
+	Used in containers where MAC addresses are synthetic and churn on every restart:
+	node is the first 48 bits of SHA-1 of os.Hostname() with the multicast bit set,
+	so it never collides with a real MAC address
+ */
+
+func NodeFromHostname() (int64, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return 0, err
+	}
+	return nodeFromName(hostname), nil
+}
+
+/**
+	Derives stable 48-bit node of Time-based UUID from the identity of the Kubernetes pod or container
+
+	Sources are tried in order: PodUIDEnv environment variable, PodUIDFile, container ID in /proc/self/cgroup;
+	node is hashed as in NodeFromHostname, so UUIDs of ephemeral pods are traced back to the workload
+	by comparing the node with the hash of the known pod UID or container ID
+
+	Returns ErrorNoPodIdentity if none of the sources is available
+ */
+
+func NodeFromPodIdentity() (int64, error) {
+	identity, err := podIdentity(os.Getenv, os.ReadFile)
+	if err != nil {
+		return 0, err
+	}
+	return nodeFromName(identity), nil
+}
+
+func podIdentity(getenv func(string) string, readFile func(string) ([]byte, error)) (string, error) {
+
+	if uid := strings.TrimSpace(getenv(PodUIDEnv)); uid != "" {
+		return uid, nil
+	}
+
+	if data, err := readFile(PodUIDFile); err == nil {
+		if uid := string(bytes.TrimSpace(data)); uid != "" {
+			return uid, nil
+		}
+	}
+
+	if data, err := readFile(containerCgroupFile); err == nil {
+		if id, ok := containerID(data); ok {
+			return id, nil
+		}
+	}
+
+	return "", ErrorNoPodIdentity
+}
+
+/**
+	Finds the 64 hex characters of container ID in the cgroup file, like
+
+	0::/kubepods/besteffort/pod0b5b2f4e/cri-containerd-4f1c...e2a9.scope
+ */
+
+func containerID(data []byte) (string, bool) {
+	for _, line := range strings.Split(string(data), "\n") {
+		for _, segment := range strings.FieldsFunc(line, func(r rune) bool { return r == '/' || r == '-' || r == ':' || r == '.' }) {
+			if len(segment) == 64 && invalidHexPos([]byte(segment)) < 0 {
+				return segment, true
+			}
+		}
+	}
+	return "", false
+}
+
+/**
+	Gets 48-bit node of Time-based UUID from the environment variable, like NodeFromEnv("TIMEUUID_NODE")
+
+	Value is hex with 0x prefix or decimal in range [0, MaxNode], so operators pin node per deployment;
+	returns ErrorNodeNotSet if the variable is not set or empty and ErrorInvalidNode for malformed values
+ */
+
+func NodeFromEnv(name string) (int64, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return 0, errors.Wrapf(ErrorNodeNotSet, "%s", name)
+	}
+	node, err := parseNode(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "%s", name)
+	}
+	return node, nil
+}
+
+func parseNode(s string) (int64, error) {
+
+	base, digits := 10, s
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		base, digits = 16, s[2:]
+	}
+
+	node, err := strconv.ParseUint(digits, base, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrorInvalidNode, "%q", s)
+	}
+	if node > uint64(MaxNode) {
+		return 0, errors.Wrapf(ErrorInvalidNode, "%q is greater than %#x", s, MaxNode)
+	}
+	return int64(node), nil
+}
+
+/**
+	Hashes the name in to 48-bit node with the multicast bit set
+ */
+
+func nodeFromName(name string) int64 {
+	digest := sha1.Sum([]byte(name))
+	return int64(binary.BigEndian.Uint64(digest[:8]) >> 16) & nodeMask | multicastNodeBit
+}
@@ -0,0 +1,123 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeFromHostname(t *testing.T) {
+
+	assert.Equal(t, int64(0x81789de45965), nodeFromName("worker-7.example.com"))
+	assert.NotEqual(t, nodeFromName("worker-7.example.com"), nodeFromName("worker-8.example.com"))
+
+	hostname, err := os.Hostname()
+	assert.NoError(t, err)
+
+	node, err := NodeFromHostname()
+	assert.NoError(t, err)
+	assert.Equal(t, nodeFromName(hostname), node)
+	assert.Equal(t, multicastNodeBit, node & multicastNodeBit)
+	assert.Equal(t, node, node & nodeMask)
+
+	uuid, err := NewTimebasedGenerator(node, 0).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, node, uuid.Node())
+
+}
+
+func TestNodeFromPodIdentity(t *testing.T) {
+
+	const containerID = "4f1c0e9d8b7a69584736251403f2e1d0c9b8a79685746352413f2e1d0c9be2a9"
+	cgroup := "0::/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0b5b2f4e.slice/cri-containerd-" + containerID + ".scope\n"
+
+	files := map[string]string{}
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+	readFile := func(name string) ([]byte, error) {
+		if data, ok := files[name]; ok {
+			return []byte(data), nil
+		}
+		return nil, os.ErrNotExist
+	}
+
+	_, err := podIdentity(getenv, readFile)
+	assert.Equal(t, ErrorNoPodIdentity, err)
+
+	files[containerCgroupFile] = "0::/\n"
+	_, err = podIdentity(getenv, readFile)
+	assert.Equal(t, ErrorNoPodIdentity, err)
+
+	files[containerCgroupFile] = cgroup
+	identity, err := podIdentity(getenv, readFile)
+	assert.NoError(t, err)
+	assert.Equal(t, containerID, identity)
+
+	files[PodUIDFile] = "7c9e6679-7425-40de-944b-e07fc1f90ae7\n"
+	identity, err = podIdentity(getenv, readFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "7c9e6679-7425-40de-944b-e07fc1f90ae7", identity)
+
+	env[PodUIDEnv] = " 0b5b2f4e-1a2b-4c3d-8e9f-a0b1c2d3e4f5 "
+	identity, err = podIdentity(getenv, readFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "0b5b2f4e-1a2b-4c3d-8e9f-a0b1c2d3e4f5", identity)
+
+	t.Setenv(PodUIDEnv, "0b5b2f4e-1a2b-4c3d-8e9f-a0b1c2d3e4f5")
+	node, err := NodeFromPodIdentity()
+	assert.NoError(t, err)
+	assert.Equal(t, nodeFromName("0b5b2f4e-1a2b-4c3d-8e9f-a0b1c2d3e4f5"), node)
+
+}
+
+func TestNodeFromEnv(t *testing.T) {
+
+	t.Setenv("TIMEUUID_NODE", "0xABCDEF012345")
+	node, err := NodeFromEnv("TIMEUUID_NODE")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0xABCDEF012345), node)
+
+	t.Setenv("TIMEUUID_NODE", " 281474976710655 ")
+	node, err = NodeFromEnv("TIMEUUID_NODE")
+	assert.NoError(t, err)
+	assert.Equal(t, MaxNode, node)
+
+	t.Setenv("TIMEUUID_NODE", "0")
+	node, err = NodeFromEnv("TIMEUUID_NODE")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), node)
+
+	// errors
+
+	t.Setenv("TIMEUUID_NODE", "")
+	_, err = NodeFromEnv("TIMEUUID_NODE")
+	assert.True(t, errors.Is(err, ErrorNodeNotSet))
+
+	for _, value := range []string{ "0x1000000000000", "281474976710656", "-1", "0x", "12ab", "0xZZ", "node-1" } {
+		t.Setenv("TIMEUUID_NODE", value)
+		_, err = NodeFromEnv("TIMEUUID_NODE")
+		assert.True(t, errors.Is(err, ErrorInvalidNode), value)
+		assert.Contains(t, err.Error(), "TIMEUUID_NODE")
+	}
+
+}
@@ -0,0 +1,134 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"github.com/pkg/errors"
+)
+
+const (
+	obfuscatorRounds   = 8
+	obfuscatorHalfBits = 61
+	obfuscatorHalfMask = uint64(1) << obfuscatorHalfBits - 1
+)
+
+var (
+	ErrorUnexpectedVersion = errors.New("unexpected UUID version")
+)
+
+/**
+	Keyed permutation of 122 payload bits of UUIDs that maps internal time-based UUIDs
+	to externally exposed values looking like randomly generated UUIDs (version 4) and back
+
+	Payload is encrypted by the 8-round Feistel network over two 61-bit halves with AES as the round function,
+	so public APIs do not leak creation time, node or counter; only the version is not stored in the result,
+	therefore obfuscator is created for the single version of internal UUIDs
+
+	Result is a function of the key, rotation of the key changes all external values
+ */
+
+type Obfuscator struct {
+	block   cipher.Block
+	version Version
+}
+
+/**
+	Creates new obfuscator for the internal UUIDs of the version with AES key of 16, 24 or 32 bytes
+ */
+
+func NewObfuscator(key []byte, version Version) (*Obfuscator, error) {
+
+	switch version {
+	case TimebasedVer1, ReorderedTimebasedVer6, UnixTimebasedVer7:
+	default:
+		return nil, errors.Errorf("unsupported time-based version %s", version.String())
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Obfuscator{ block: block, version: version }, nil
+}
+
+/**
+	Converts internal UUID of the obfuscator version to the external randomly generated UUID (version 4)
+ */
+
+func (this *Obfuscator) Obfuscate(uuid UUID) (UUID, error) {
+
+	if uuid.Version() != this.version || uuid.Variant() != IETF {
+		return Empty, errors.Wrapf(ErrorUnexpectedVersion, "%s in '%s'", uuid.Version().String(), uuid.String())
+	}
+
+	left, right := obfuscatorSplit(uuid)
+	for round := 0; round < obfuscatorRounds; round++ {
+		left, right = right, left ^ this.roundFunc(round, right)
+	}
+
+	return obfuscatorJoin(left, right, RandomlyGeneratedVer4), nil
+}
+
+/**
+	Converts external UUID issued by Obfuscate back to the internal UUID
+ */
+
+func (this *Obfuscator) Deobfuscate(uuid UUID) (UUID, error) {
+
+	if uuid.Version() != RandomlyGeneratedVer4 || uuid.Variant() != IETF {
+		return Empty, errors.Wrapf(ErrorUnexpectedVersion, "%s in '%s'", uuid.Version().String(), uuid.String())
+	}
+
+	left, right := obfuscatorSplit(uuid)
+	for round := obfuscatorRounds - 1; round >= 0; round-- {
+		left, right = right ^ this.roundFunc(round, left), left
+	}
+
+	return obfuscatorJoin(left, right, this.version), nil
+}
+
+func (this *Obfuscator) roundFunc(round int, half uint64) uint64 {
+	var block [16]byte
+	block[0] = byte(round)
+	binary.BigEndian.PutUint64(block[8:], half)
+	this.block.Encrypt(block[:], block[:])
+	return binary.BigEndian.Uint64(block[:8]) & obfuscatorHalfMask
+}
+
+/**
+	Splits 122 bits of UUID without version and variant in to two 61-bit halves
+ */
+
+func obfuscatorSplit(uuid UUID) (left, right uint64) {
+	high := uuid.mostSigBits >> 16 << 12 | uuid.mostSigBits & 0xFFF
+	low := uuid.leastSigBits & counterMask
+	return high << 1 | low >> obfuscatorHalfBits, low & obfuscatorHalfMask
+}
+
+func obfuscatorJoin(left, right uint64, version Version) (uuid UUID) {
+	high := left >> 1
+	low := left & 1 << obfuscatorHalfBits | right
+	uuid.mostSigBits = high >> 12 << 16 | uint64(version) << 12 | high & 0xFFF
+	uuid.leastSigBits = low | variantIETFBits
+	return uuid
+}
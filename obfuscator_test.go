@@ -0,0 +1,85 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObfuscator(t *testing.T) {
+
+	key := []byte("0123456789abcdef")
+	r := rand.New(rand.NewSource(1))
+
+	for _, version := range []Version{ TimebasedVer1, ReorderedTimebasedVer6, UnixTimebasedVer7 } {
+
+		obfuscator, err := NewObfuscator(key, version)
+		assert.NoError(t, err)
+
+		for i := 0; i != 1000; i = i + 1 {
+			uuid := GenerateUUID(r, version)
+
+			external, err := obfuscator.Obfuscate(uuid)
+			assert.NoError(t, err)
+			assert.Equal(t, RandomlyGeneratedVer4, external.Version())
+			assert.Equal(t, IETF, external.Variant())
+			assert.False(t, uuid.Equal(external))
+
+			internal, err := obfuscator.Deobfuscate(external)
+			assert.NoError(t, err)
+			assert.True(t, uuid.Equal(internal))
+		}
+	}
+
+	// neighbour UUIDs do not look related
+
+	obfuscator, _ := NewObfuscator(key, TimebasedVer1)
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(1700000000000)
+	first, _ := obfuscator.Obfuscate(uuid)
+	uuid.SetCounter(1)
+	second, _ := obfuscator.Obfuscate(uuid)
+	assert.NotEqual(t, first.MostSignificantBits(), second.MostSignificantBits())
+	assert.NotEqual(t, first.LeastSignificantBits(), second.LeastSignificantBits())
+
+	// result depends on the key
+
+	other, _ := NewObfuscator([]byte("fedcba9876543210"), TimebasedVer1)
+	actual, _ := other.Obfuscate(uuid)
+	assert.False(t, second.Equal(actual))
+
+	// errors
+
+	_, err := NewObfuscator(key, RandomlyGeneratedVer4)
+	assert.Error(t, err)
+
+	_, err = NewObfuscator([]byte("short"), TimebasedVer1)
+	assert.Error(t, err)
+
+	_, err = obfuscator.Obfuscate(NewUUID(UnixTimebasedVer7))
+	assert.True(t, errors.Is(err, ErrorUnexpectedVersion))
+
+	_, err = obfuscator.Deobfuscate(NewUUID(TimebasedVer1))
+	assert.True(t, errors.Is(err, ErrorUnexpectedVersion))
+
+}
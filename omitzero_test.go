@@ -0,0 +1,46 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+//go:build go1.24
+
+package timeuuid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOmitZero(t *testing.T) {
+
+	type event struct {
+		ID     UUID `json:"id"`
+		Parent UUID `json:"parent,omitzero"`
+	}
+
+	data, err := json.Marshal(event{ ID: NewUUID(TimebasedVer1) })
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"00000000-0000-1000-8000-000000000000"}`, string(data))
+
+	parent := NewUUID(RandomlyGeneratedVer4)
+	data, err = json.Marshal(event{ Parent: parent })
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":"00000000-0000-0000-0000-000000000000","parent":"00000000-0000-4000-8000-000000000000"}`, string(data))
+
+}
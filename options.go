@@ -0,0 +1,163 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"io"
+	"time"
+)
+
+/**
+	Option of NewGenerator
+ */
+
+type Option func(*generatorOptions)
+
+type generatorOptions struct {
+	version       Version
+	clock         func() time.Time
+	random        io.Reader
+	node          *int64
+	clockSequence *int
+}
+
+/**
+	Sets version of issued UUIDs: TimebasedVer1 (default), RandomlyGeneratedVer4 or UnixTimebasedVer7
+ */
+
+func WithVersion(version Version) Option {
+	return func(opts *generatorOptions) {
+		opts.version = version
+	}
+}
+
+/**
+	Sets clock of generator, time.Now by default
+ */
+
+func WithClock(clock func() time.Time) Option {
+	return func(opts *generatorOptions) {
+		opts.clock = clock
+	}
+}
+
+/**
+	Sets source of random bytes, pseudo-random cryptographic generator by default
+ */
+
+func WithRand(random io.Reader) Option {
+	return func(opts *generatorOptions) {
+		opts.random = random
+	}
+}
+
+/**
+	Sets 48-bit node of Time-based UUIDs (version 1), random node with the multicast bit by default
+ */
+
+func WithNode(node int64) Option {
+	return func(opts *generatorOptions) {
+		opts.node = &node
+	}
+}
+
+/**
+	Sets initial 14-bit clock sequence of Time-based UUIDs (version 1), random by default
+ */
+
+func WithClockSequence(clockSequence int) Option {
+	return func(opts *generatorOptions) {
+		opts.clockSequence = &clockSequence
+	}
+}
+
+/**
+	Creates new generator configured by options
+
+	Random defaults of node and clock sequence are read from the source of WithRand
+ */
+
+func NewGenerator(opts ...Option) (Generator, error) {
+
+	options := generatorOptions{
+		version: TimebasedVer1,
+		clock:   time.Now,
+		random:  rand.Reader,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	switch options.version {
+
+	case TimebasedVer1:
+		var randomBytes [8]byte
+		if options.node == nil || options.clockSequence == nil {
+			if _, err := io.ReadFull(options.random, randomBytes[:]); err != nil {
+				return nil, err
+			}
+		}
+		node := int64(binary.BigEndian.Uint64(randomBytes[:])) & nodeMask | multicastNodeBit
+		if options.node != nil {
+			node = *options.node
+		}
+		clockSequence := int(binary.BigEndian.Uint16(randomBytes[:]))
+		if options.clockSequence != nil {
+			clockSequence = *options.clockSequence
+		}
+		gen := NewTimebasedGenerator(node, clockSequence)
+		gen.clock = options.clock
+		return gen, nil
+
+	case RandomlyGeneratedVer4:
+		return &randomGenerator{ random: options.random }, nil
+
+	case UnixTimebasedVer7:
+		gen := NewUnixTimebasedGenerator()
+		gen.clock = options.clock
+		gen.random = options.random
+		return gen, nil
+
+	default:
+		return nil, errors.Errorf("unsupported generator version %s", options.version.String())
+	}
+}
+
+/**
+	Randomly generated UUID (version 4) generator reading the source of random bytes
+ */
+
+type randomGenerator struct {
+	random io.Reader
+}
+
+func (this *randomGenerator) Next() (uuid UUID, err error) {
+
+	done := traceStart(TraceGenerate, "randomGenerator.Next")
+	defer func() { done(err) }()
+
+	var randomBytes [16]byte
+	if _, err = io.ReadFull(this.random, randomBytes[:]); err != nil {
+		return Empty, err
+	}
+	return randomUUIDFromWords(binary.BigEndian.Uint64(randomBytes[:8]), binary.BigEndian.Uint64(randomBytes[8:])), nil
+}
@@ -0,0 +1,76 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGenerator(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789123400, time.UTC)
+	clock := func() time.Time { return now }
+
+	gen, err := NewGenerator(WithClock(clock), WithNode(0xABCDEF), WithClockSequence(0x123))
+	assert.NoError(t, err)
+	uuid, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, now, uuid.Time().UTC())
+	assert.Equal(t, int64(0xABCDEF), uuid.Node())
+	assert.Equal(t, 0x123, uuid.ClockSequence())
+
+	// defaults are read from the random source
+
+	random := bytes.NewReader([]byte{ 0x12, 0x34, 0xA0, 0xB1, 0xC2, 0xD3, 0xE4, 0xF5 })
+	gen, err = NewGenerator(WithClock(clock), WithRand(random))
+	assert.NoError(t, err)
+	uuid, _ = gen.Next()
+	assert.Equal(t, int64(0xA1B1C2D3E4F5), uuid.Node())
+	assert.Equal(t, 0x1234, uuid.ClockSequence())
+
+	_, err = NewGenerator(WithRand(bytes.NewReader(nil)))
+	assert.Error(t, err)
+
+	// other versions
+
+	gen, err = NewGenerator(WithVersion(UnixTimebasedVer7), WithClock(clock), WithRand(bytes.NewReader(bytes.Repeat([]byte{ 0xFF }, 8))))
+	assert.NoError(t, err)
+	uuid, err = gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, UnixTimebasedVer7, uuid.Version())
+	assert.Equal(t, now.UnixMilli(), uuid.UnixTimeMillis())
+	assert.Equal(t, uint64(0xBFFFFFFFFFFFFFFF), uuid.leastSigBits)
+
+	gen, err = NewGenerator(WithVersion(RandomlyGeneratedVer4), WithRand(bytes.NewReader(bytes.Repeat([]byte{ 0xFF }, 16))))
+	assert.NoError(t, err)
+	uuid, err = gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "ffffffff-ffff-4fff-bfff-ffffffffffff", uuid.String())
+	_, err = gen.Next()
+	assert.Error(t, err)
+
+	_, err = NewGenerator(WithVersion(NamebasedVer5))
+	assert.Error(t, err)
+
+}
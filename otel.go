@@ -0,0 +1,63 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/sha1"
+	"encoding/binary"
+	"time"
+)
+
+/**
+	Creates UUID from the 16 bytes of OpenTelemetry TraceID
+
+	Bytes are kept as is, so version and variant of the result are whatever random bits of the trace id hold
+ */
+
+func FromTraceID(traceID [16]byte) UUID {
+	return FromArray(traceID)
+}
+
+/**
+	Gets 16 bytes of UUID as OpenTelemetry TraceID
+ */
+
+func (this UUID) TraceID() [16]byte {
+	return this.Array()
+}
+
+/**
+	Derives Time-based UUID (version 1) from the span context
+
+	Time is usually the start time of the span, counter holds 62 bits of SHA-1 digest of trace id and span id,
+	so the same span always gets the same UUID and UUIDs of spans are ordered by time
+ */
+
+func FromSpanContext(traceID [16]byte, spanID [8]byte, t time.Time) UUID {
+
+	var data [24]byte
+	copy(data[:16], traceID[:])
+	copy(data[16:], spanID[:])
+	digest := sha1.Sum(data[:])
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(t)
+	uuid.SetCounterUnsigned(binary.BigEndian.Uint64(digest[:8]))
+	return uuid
+}
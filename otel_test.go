@@ -0,0 +1,51 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceID(t *testing.T) {
+
+	var traceID [16]byte
+	hex.Decode(traceID[:], []byte("4bf92f3577b34da6a3ce929d0e0e4736"))
+
+	uuid := FromTraceID(traceID)
+	assert.Equal(t, "4bf92f35-77b3-4da6-a3ce-929d0e0e4736", uuid.String())
+	assert.Equal(t, traceID, uuid.TraceID())
+
+	var spanID [8]byte
+	hex.Decode(spanID[:], []byte("00f067aa0ba902b7"))
+
+	start := time.Date(2024, 3, 1, 12, 0, 0, 123456700, time.UTC)
+	uuid = FromSpanContext(traceID, spanID, start)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, start, uuid.Time().UTC())
+	assert.True(t, uuid.Equal(FromSpanContext(traceID, spanID, start)))
+
+	spanID[7]++
+	assert.False(t, uuid.Equal(FromSpanContext(traceID, spanID, start)))
+
+}
@@ -0,0 +1,57 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrorInvalidLength = errors.New("invalid UUID length")
+	ErrorInvalidFormat = errors.New("invalid UUID format")
+)
+
+/**
+	Parse error with the position of the offending character in the input
+
+	Matches ErrorInvalidFormat in errors.Is, use errors.As to get the position
+ */
+
+type InvalidFormatError struct {
+	Input string
+	Pos   int
+	Msg   string
+}
+
+func (this *InvalidFormatError) Error() string {
+	return fmt.Sprintf("%s at position %d in %q", this.Msg, this.Pos, this.Input)
+}
+
+func (this *InvalidFormatError) Is(target error) bool {
+	return target == ErrorInvalidFormat
+}
+
+func invalidLength(input []byte) error {
+	return errors.Wrapf(ErrorInvalidLength, "%d bytes in %q", len(input), input)
+}
+
+func invalidFormat(input []byte, pos int, msg string) error {
+	return &InvalidFormatError{ Input: string(input), Pos: pos, Msg: msg }
+}
@@ -0,0 +1,73 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrors(t *testing.T) {
+
+	_, err := Parse("6ba7b810")
+	assert.True(t, errors.Is(err, ErrorInvalidLength))
+	assert.False(t, errors.Is(err, ErrorInvalidFormat))
+	assert.Equal(t, "8 bytes in \"6ba7b810\": invalid UUID length", err.Error())
+
+	for s, pos := range map[string]int{
+		"6ba7b810-9dad-11d1-80b4_00c04fd430c8": 23,
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cg": 35,
+		"{6ba7b810-9dad-11d1-80b4-00c04fd43 c8}": 34,
+		"urx:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8": 0,
+		"urn:uuid:6ba7b810+9dad-11d1-80b4-00c04fd430c8": 17,
+		"6ba7b8109dad11d180b400c04fd430cx": 31,
+		"U0tEoZvxPSC3HsxOt3xX+w": 20,
+		"U0tEoZvxPSC3HsxOt3xXLx": 20,
+		"2K9D2A36ZH7MGBE7PC9TVQRNSU": 25,
+		"8ZZZZZZZZZZZZZZZZZZZZZZZZZ": 0,
+	} {
+		_, err = Parse(s)
+		assert.True(t, errors.Is(err, ErrorInvalidFormat), s)
+		assert.False(t, errors.Is(err, ErrorInvalidLength), s)
+
+		var formatErr *InvalidFormatError
+		assert.True(t, errors.As(err, &formatErr), s)
+		assert.Equal(t, pos, formatErr.Pos, s)
+		assert.Equal(t, s, formatErr.Input)
+	}
+
+	_, err = Parse("2K9D2A36-H7MGBE7PC9TVQRNSF")
+	assert.True(t, errors.Is(err, ErrorInvalidLength))
+	assert.False(t, errors.Is(err, ErrorInvalidFormat))
+
+	_, err = Parse("6ba7b810-9dad-11d1-80b4-00c04fd430cg")
+	assert.Equal(t, "invalid hex character 'g' at position 35 in \"6ba7b810-9dad-11d1-80b4-00c04fd430cg\"", err.Error())
+
+	// errors are kept by UnmarshalText and UnmarshalJSON
+
+	var uuid UUID
+	err = uuid.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430cg"))
+	assert.True(t, errors.Is(err, ErrorInvalidFormat))
+
+	err = uuid.UnmarshalJSON([]byte(`"6ba7b810"`))
+	assert.True(t, errors.Is(err, ErrorInvalidLength))
+
+}
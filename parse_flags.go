@@ -0,0 +1,137 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+)
+
+/**
+	Flags of ParseWithFlags that accept non-standard representations, zero value is the strict ParseBytes
+ */
+
+type ParseFlags int
+
+const (
+
+	/**
+		Accepts 0x-prefixed 32 hex digits, CQL blob literal style 0x6ba7b8109dad11d180b400c04fd430c8
+	 */
+
+	AllowHexPrefix ParseFlags = 1
+
+	/**
+		Accepts hex with internal whitespace and colons as dumped by tools, 6b:a7:b8:10:9d:ad:...
+	 */
+
+	AllowSeparators ParseFlags = 2
+
+	/**
+		Accepts surrounding whitespace and one pair of single or double quotes, ' "6ba7b810-9dad-11d1-80b4-00c04fd430c8" '
+	 */
+
+	AllowQuotes ParseFlags = 4
+
+	/**
+		All flags
+	 */
+
+	Lenient = AllowHexPrefix | AllowSeparators | AllowQuotes
+)
+
+/**
+	Parses string representation of UUID with all lenient flags
+
+	Trims surrounding whitespace and quotes, accepts 0x-prefixed hex and hex with separators,
+	while Parse stays strict
+ */
+
+func ParseLenient(s string) (uuid UUID, err error) {
+	done := traceStart(TraceParse, "ParseLenient")
+	uuid, err = parseWithFlags([]byte(s), Lenient)
+	done(err)
+	return uuid, err
+}
+
+/**
+	Parses bytes are a string representation of UUID accepting additional forms enabled by flags
+
+	Positions in parse errors are given in the input without separators
+ */
+
+func ParseWithFlags(src []byte, flags ParseFlags) (uuid UUID, err error) {
+	done := traceStart(TraceParse, "ParseWithFlags")
+	uuid, err = parseWithFlags(src, flags)
+	done(err)
+	return uuid, err
+}
+
+func parseWithFlags(src []byte, flags ParseFlags) (UUID, error) {
+
+	if flags & AllowQuotes != 0 {
+		src = trimQuotes(bytes.TrimSpace(src))
+	}
+
+	if flags & AllowSeparators != 0 {
+		// colons of the URN prefix are not separators
+		if len(src) > 9 && bytes.EqualFold(src[:9], []byte("urn:uuid:")) {
+			src = append([]byte("urn:uuid:"), removeSeparators(src[9:])...)
+		} else {
+			src = removeSeparators(src)
+		}
+	}
+
+	if flags & AllowHexPrefix != 0 && len(src) == 32 + 2 && src[0] == '0' && (src[1] == 'x' || src[1] == 'X') {
+		return parseBytes(src[2:])
+	}
+
+	return parseBytes(src)
+}
+
+func trimQuotes(src []byte) []byte {
+	if n := len(src); n >= 2 && (src[0] == '"' || src[0] == '\'') && src[n-1] == src[0] {
+		return src[1:n-1]
+	}
+	return src
+}
+
+func removeSeparators(src []byte) []byte {
+
+	n := 0
+	for _, c := range src {
+		if !isSeparator(c) {
+			n++
+		}
+	}
+	if n == len(src) {
+		return src
+	}
+
+	dst := make([]byte, 0, n)
+	for _, c := range src {
+		if !isSeparator(c) {
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+func isSeparator(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ':'
+}
@@ -0,0 +1,117 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithFlags(t *testing.T) {
+
+	expected := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	for s, flags := range map[string]ParseFlags{
+		expected: 0,
+		"0x6ba7b8109dad11d180b400c04fd430c8": AllowHexPrefix,
+		"0X6BA7B8109DAD11D180B400C04FD430C8": AllowHexPrefix,
+		"6b:a7:b8:10:9d:ad:11:d1:80:b4:00:c0:4f:d4:30:c8": AllowSeparators,
+		"6ba7b810 9dad11d1\t80b400c0\n4fd430c8": AllowSeparators,
+		"0x 6ba7b810 9dad11d1 80b400c0 4fd430c8": AllowHexPrefix | AllowSeparators,
+	} {
+		uuid, err := ParseWithFlags([]byte(s), flags)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, uuid.String(), s)
+	}
+
+	// flags are required
+
+	for s, flags := range map[string]ParseFlags{
+		"0x6ba7b8109dad11d180b400c04fd430c8": AllowSeparators,
+		"6b:a7:b8:10:9d:ad:11:d1:80:b4:00:c0:4f:d4:30:c8": AllowHexPrefix,
+		"0x6ba7b8109dad11d180b400c04fd430": AllowHexPrefix,
+		"0x6ba7b8109dad11d180b400c04fd430zz": AllowHexPrefix,
+	} {
+		_, err := ParseWithFlags([]byte(s), flags)
+		assert.Error(t, err, s)
+	}
+
+	_, err := Parse("0x6ba7b8109dad11d180b400c04fd430c8")
+	assert.Error(t, err)
+
+}
+
+func TestParseLenient(t *testing.T) {
+
+	expected := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	for _, s := range []string{
+		expected,
+		"  6ba7b810-9dad-11d1-80b4-00c04fd430c8\n",
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		` '6ba7b810-9dad-11d1-80b4-00c04fd430c8' `,
+		`"{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"`,
+		"Urn:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"'URN:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8'",
+		"0x6ba7b8109dad11d180b400c04fd430c8",
+		" 6b:a7:b8:10:9d:ad:11:d1:80:b4:00:c0:4f:d4:30:c8 ",
+	} {
+		uuid, err := ParseLenient(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, uuid.String(), s)
+	}
+
+	for _, s := range []string{
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8'`,
+		`""`,
+		"urn:uuid:",
+		"[6ba7b810-9dad-11d1-80b4-00c04fd430c8]",
+	} {
+		_, err := ParseLenient(s)
+		assert.Error(t, err, s)
+	}
+
+	// Parse stays strict
+
+	for _, s := range []string{
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		"[6ba7b810-9dad-11d1-80b4-00c04fd430c8]",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8)",
+		" 6ba7b810-9dad-11d1-80b4-00c04fd430c8 ",
+	} {
+		_, err := Parse(s)
+		assert.True(t, errors.Is(err, ErrorInvalidFormat), s)
+	}
+
+	uuid, err := Parse("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, uuid.String())
+
+	// JSON strips quotes itself
+
+	err = uuid.UnmarshalJSON([]byte(`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, uuid.String())
+
+	err = uuid.UnmarshalJSON([]byte(`{6ba7b810-9dad-11d1-80b4-00c04fd430c8}`))
+	assert.Error(t, err)
+
+}
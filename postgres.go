@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+)
+
+/**
+	Creates generator producing UUIDs with the same layout as Postgres uuid_generate_v1() from uuid-ossp
+
+	node is the IEEE 802 address of the machine, clock sequence is initialized randomly on start like in uuid-ossp
+
+	Note that Postgres compares uuid values as 16 unsigned bytes (see ComparePostgres), so version 1 values
+	are not ordered by time in the btree index, because time_low is stored first.
+ */
+
+func NewPostgresGenerateV1(node int64) (*TimebasedGenerator, error) {
+	clockSequence, err := randomClockSequence()
+	if err != nil {
+		return nil, err
+	}
+	return NewTimebasedGenerator(node, clockSequence), nil
+}
+
+/**
+	Creates generator producing UUIDs with the same layout as Postgres uuid_generate_v1mc() from uuid-ossp
+
+	Node is random with the multicast bit set, clock sequence is random
+ */
+
+func NewPostgresGenerateV1MC() (*TimebasedGenerator, error) {
+	var randomBytes [8]byte
+	if _, err := rand.Read(randomBytes[:]); err != nil {
+		return nil, err
+	}
+	node := int64(binary.BigEndian.Uint64(randomBytes[:])) & nodeMask | multicastNodeBit
+	return NewPostgresGenerateV1(node)
+}
+
+/**
+	Generates UUID with the same layout as Postgres gen_random_uuid() and uuid_generate_v4()
+ */
+
+func PostgresGenRandomUUID() (UUID, error) {
+	return RandomUUID()
+}
+
+/**
+	Creates generator producing UUIDs with the same layout as Postgres 18 uuidv7()
+
+	Both use 12-bit sub-millisecond fraction in rand_a, so values issued by the client and by the database
+	have the same index locality: they are ordered by time in the btree index.
+ */
+
+func NewPostgresUUIDv7() *UnixTimebasedGenerator {
+	return NewUnixTimebasedGenerator()
+}
+
+/**
+	Compares two UUIDs in the same order as Postgres uuid_cmp()
+
+	Postgres compares uuid values as 16 unsigned bytes in the canonical order, so the result is equal to
+	bytes.Compare of MarshalBinary output
+
+	return -1 if left < right, 0 if left == right, +1 if left > right
+ */
+
+func ComparePostgres(left, right UUID) int {
+	switch {
+	case left.mostSigBits < right.mostSigBits:
+		return -1
+	case left.mostSigBits > right.mostSigBits:
+		return 1
+	case left.leastSigBits < right.leastSigBits:
+		return -1
+	case left.leastSigBits > right.leastSigBits:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func randomClockSequence() (int, error) {
+	var randomBytes [2]byte
+	if _, err := rand.Read(randomBytes[:]); err != nil {
+		return 0, err
+	}
+	return int(binary.BigEndian.Uint16(randomBytes[:])) & clockSequenceBits, nil
+}
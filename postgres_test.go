@@ -0,0 +1,80 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgres(t *testing.T) {
+
+	gen, err := NewPostgresGenerateV1(int64(0x0000112233445566))
+	if err != nil {
+		t.Fatal("fail to create generator ", err)
+	}
+
+	uuid, _ := gen.Next()
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, int64(0x0000112233445566), uuid.Node())
+
+	mc, err := NewPostgresGenerateV1MC()
+	if err != nil {
+		t.Fatal("fail to create generator ", err)
+	}
+	assert.Equal(t, multicastNodeBit, mc.Node() & multicastNodeBit)
+
+	uuid, err = PostgresGenRandomUUID()
+	assert.NoError(t, err)
+	assert.Equal(t, RandomlyGeneratedVer4, uuid.Version())
+
+	// index order equivalence of ComparePostgres and byte order
+
+	values := make([]UUID, 0, 200)
+	for i := 0; i != 100; i = i + 1 {
+		values = append(values, CreateUUID(rand.Int63() - rand.Int63(), rand.Int63() - rand.Int63()))
+	}
+
+	v7 := NewPostgresUUIDv7()
+	for i := 0; i != 100; i = i + 1 {
+		uuid, err := v7.Next()
+		if err != nil {
+			t.Fatal("fail to generate uuid ", err)
+		}
+		values = append(values, uuid)
+	}
+
+	for _, left := range values {
+		for _, right := range values {
+			leftBin, _ := left.MarshalBinary()
+			rightBin, _ := right.MarshalBinary()
+			assert.Equal(t, bytes.Compare(leftBin, rightBin), ComparePostgres(left, right))
+		}
+	}
+
+	// v7 issued sequentially are ordered like in the Postgres index
+
+	for i := 101; i != len(values); i = i + 1 {
+		assert.Equal(t, -1, ComparePostgres(values[i-1], values[i]))
+	}
+
+}
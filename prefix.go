@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	counterBits = 62
+)
+
+/**
+	Sets counter composed of the prefix in the top bits of the 62-bit counter and the counter in the rest bits
+
+	Used to store tenant or partition identifier in the counter, so multi-tenant tables keyed by
+	MarshalSortableBinary keep UUIDs of the tenant together within the same timestamp
+
+	Bits is in range [1, 61], prefix is less than 2^bits and counter is less than 2^(62-bits),
+	returns ErrorInvalidField otherwise
+ */
+
+func (this *UUID) SetCounterWithPrefix(prefix uint64, bits int, counter uint64) error {
+
+	if bits < 1 || bits >= counterBits {
+		return errors.Wrapf(ErrorInvalidField, "prefix bits %d is out of range [1, %d]", bits, counterBits - 1)
+	}
+	if prefix >> bits != 0 {
+		return errors.Wrapf(ErrorInvalidField, "prefix %#x does not fit in to %d bits", prefix, bits)
+	}
+	if counter >> (counterBits - bits) != 0 {
+		return errors.Wrapf(ErrorInvalidField, "counter %#x does not fit in to %d bits", counter, counterBits - bits)
+	}
+
+	this.SetCounterUnsigned(prefix << (counterBits - bits) | counter)
+	return nil
+}
+
+/**
+	Gets prefix stored in the top bits of the counter by SetCounterWithPrefix
+
+	Returns 0 if bits is out of range [1, 61]
+ */
+
+func (this UUID) CounterPrefix(bits int) uint64 {
+	if bits < 1 || bits >= counterBits {
+		return 0
+	}
+	return this.CounterUnsigned() >> (counterBits - bits)
+}
+
+/**
+	Gets counter without the prefix of the top bits stored by SetCounterWithPrefix
+
+	Returns full counter if bits is out of range [1, 61]
+ */
+
+func (this UUID) CounterWithoutPrefix(bits int) uint64 {
+	if bits < 1 || bits >= counterBits {
+		return this.CounterUnsigned()
+	}
+	return this.CounterUnsigned() & (uint64(1) << (counterBits - bits) - 1)
+}
@@ -0,0 +1,71 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterWithPrefix(t *testing.T) {
+
+	uuid := UUIDAt(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	at := uuid.Time()
+
+	assert.NoError(t, uuid.SetCounterWithPrefix(0xABC, 12, 0x123456789))
+	assert.Equal(t, uint64(0xABC), uuid.CounterPrefix(12))
+	assert.Equal(t, uint64(0x123456789), uuid.CounterWithoutPrefix(12))
+	assert.Equal(t, uint64(0xABC) << 50 | 0x123456789, uuid.CounterUnsigned())
+	assert.Equal(t, at, uuid.Time())
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+
+	assert.NoError(t, uuid.SetCounterWithPrefix(1, 61, 1))
+	assert.Equal(t, uint64(1), uuid.CounterPrefix(61))
+	assert.Equal(t, uint64(1), uuid.CounterWithoutPrefix(61))
+	assert.Equal(t, uint64(0), uuid.CounterPrefix(0))
+	assert.Equal(t, uuid.CounterUnsigned(), uuid.CounterWithoutPrefix(62))
+
+	// tenant keeps UUIDs together within the same timestamp
+
+	var first, second UUID = uuid, uuid
+	assert.NoError(t, first.SetCounterWithPrefix(1, 8, 1 << 54 - 1))
+	assert.NoError(t, second.SetCounterWithPrefix(2, 8, 0))
+	firstKey, _ := first.MarshalSortableBinary()
+	secondKey, _ := second.MarshalSortableBinary()
+	assert.True(t, bytes.Compare(firstKey, secondKey) < 0)
+
+	// validation
+
+	for _, c := range []struct{ prefix uint64; bits int; counter uint64 }{
+		{ 0, 0, 0 },
+		{ 0, 62, 0 },
+		{ 0x1000, 12, 0 },
+		{ 0, 12, 1 << 50 },
+	} {
+		err := uuid.SetCounterWithPrefix(c.prefix, c.bits, c.counter)
+		assert.True(t, errors.Is(err, ErrorInvalidField))
+	}
+	assert.Equal(t, uint64(1), uuid.CounterPrefix(61))
+
+}
@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+const (
+
+	/**
+		Upper bound of timestamps issued by GenerateUUID: 2100-01-01 in 100 nanoseconds since unix epoch,
+		fixed so quick.Config with the seeded source reproduces the failing case
+	 */
+
+	quickMaxUnixTime100Nanos = int64(4102444800) * one100NanosInSecond
+)
+
+/**
+	Versions of UUIDs issued by the Generate method of testing/quick
+ */
+
+var quickVersions = []Version{
+	TimebasedVer1,
+	DCESecurityVer2,
+	NamebasedVer3,
+	RandomlyGeneratedVer4,
+	NamebasedVer5,
+	ReorderedTimebasedVer6,
+	UnixTimebasedVer7,
+	CustomVer8,
+}
+
+/**
+	Generates well-formed UUID of the random version
+
+	Generate implements the quick.Generator interface of testing/quick without importing it,
+	so property-based tests over functions taking UUIDs get well-formed values instead of zero structs;
+	quicktest subpackage fills arguments with UUIDs of the specific version
+ */
+
+func (UUID) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(GenerateUUID(r, quickVersions[r.Intn(len(quickVersions))]))
+}
+
+/**
+	Generates well-formed UUID of the version from the source of randomness
+
+	Timestamps of the time-based versions are between unix epoch and 2100-01-01,
+	other versions get random payload with the version and IETF variant bits
+ */
+
+func GenerateUUID(r *rand.Rand, version Version) UUID {
+
+	unixTime100Nanos := r.Int63n(quickMaxUnixTime100Nanos)
+
+	switch version {
+
+	case TimebasedVer1:
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetUnixTime100Nanos(unixTime100Nanos)
+		uuid.SetCounterUnsigned(r.Uint64())
+		return uuid
+
+	case NamebasedVer3, NamebasedVer5:
+		var name [16]byte
+		r.Read(name[:])
+		var uuid UUID
+		uuid.SetName(name[:], version)
+		return uuid
+
+	case RandomlyGeneratedVer4:
+		return randomUUIDFromWords(r.Uint64(), r.Uint64())
+
+	case ReorderedTimebasedVer6:
+		var uuid UUID
+		uuid.mostSigBits = reorderedTimebasedBits(uint64(unixTime100Nanos + num100NanosSinceUUIDEpoch))
+		uuid.leastSigBits = r.Uint64() & counterMask | variantIETFBits
+		return uuid
+
+	case UnixTimebasedVer7:
+		var uuid UUID
+		uuid.mostSigBits = uint64(unixTime100Nanos / one100NanosInMillis) << 16 | unixTimebasedVersionBits | r.Uint64() & subMillisFractionBits
+		uuid.leastSigBits = r.Uint64() & counterMask | variantIETFBits
+		return uuid
+
+	default:
+		return CreateUUID(int64(r.Uint64()), int64(r.Uint64())).Canonicalize(version)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var quickMaxTime = time.Unix(0, quickMaxUnixTime100Nanos * 100)
+
+func TestQuick(t *testing.T) {
+
+	var _ quick.Generator = UUID{}
+
+	// Generate gives valid UUIDs
+
+	err := quick.Check(func(uuid UUID) bool {
+		return uuid.ValidateAt(quickMaxTime) == nil
+	}, nil)
+	assert.NoError(t, err)
+
+	// every version is well formed
+
+	r := rand.New(rand.NewSource(1))
+	for _, version := range quickVersions {
+		for i := 0; i != 100; i = i + 1 {
+			uuid := GenerateUUID(r, version)
+			assert.Equal(t, version, uuid.Version())
+			assert.Equal(t, IETF, uuid.Variant())
+			assert.NoError(t, uuid.ValidateAt(quickMaxTime))
+		}
+	}
+
+	// seeded source reproduces the values
+
+	first := GenerateUUID(rand.New(rand.NewSource(42)), TimebasedVer1)
+	second := GenerateUUID(rand.New(rand.NewSource(42)), TimebasedVer1)
+	assert.Equal(t, first, second)
+	assert.True(t, first.Time().Before(quickMaxTime))
+
+	// round trip of text marshaling
+
+	err = quick.Check(func(uuid UUID) bool {
+		actual, err := Parse(uuid.String())
+		return err == nil && actual.Equal(uuid)
+	}, nil)
+	assert.NoError(t, err)
+
+}
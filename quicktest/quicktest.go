@@ -0,0 +1,50 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package quicktest
+
+import (
+	"arpabet.pkg.is/timeuuid"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+)
+
+/**
+	Gets quick.Config Values function for the tested function f that fills UUID arguments
+	with well-formed UUIDs of the version and the rest of arguments with quick.Value
+
+	Kept out of the timeuuid package, so binaries importing timeuuid do not get the flags of testing/quick
+ */
+
+func Values(f any, version timeuuid.Version) func([]reflect.Value, *rand.Rand) {
+	fType := reflect.TypeOf(f)
+	uuidType := reflect.TypeOf(timeuuid.UUID{})
+	return func(args []reflect.Value, r *rand.Rand) {
+		for i := range args {
+			argType := fType.In(i)
+			if argType == uuidType {
+				args[i] = reflect.ValueOf(timeuuid.GenerateUUID(r, version))
+			} else if value, ok := quick.Value(argType, r); ok {
+				args[i] = value
+			} else {
+				args[i] = reflect.Zero(argType)
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package quicktest
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"arpabet.pkg.is/timeuuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+
+	max := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f := func(uuid timeuuid.UUID, n int) bool {
+		return uuid.Version() == timeuuid.UnixTimebasedVer7 && uuid.ValidateAt(max) == nil
+	}
+	err := quick.Check(f, &quick.Config{ Values: Values(f, timeuuid.UnixTimebasedVer7) })
+	assert.NoError(t, err)
+
+	// seeded config reproduces the values
+
+	var first, second []timeuuid.UUID
+	collect := func(dst *[]timeuuid.UUID) func(timeuuid.UUID) bool {
+		return func(uuid timeuuid.UUID) bool {
+			*dst = append(*dst, uuid)
+			return true
+		}
+	}
+	g := collect(&first)
+	assert.NoError(t, quick.Check(g, &quick.Config{ Rand: rand.New(rand.NewSource(7)), Values: Values(g, timeuuid.TimebasedVer1) }))
+	g = collect(&second)
+	assert.NoError(t, quick.Check(g, &quick.Config{ Rand: rand.New(rand.NewSource(7)), Values: Values(g, timeuuid.TimebasedVer1) }))
+	assert.Equal(t, first, second)
+
+}
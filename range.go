@@ -0,0 +1,126 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"iter"
+	"time"
+)
+
+/**
+	Half-open range [Lo, Hi) of Time-based UUIDs (version 1) in CompareTimeuuid order
+
+	The same order as MarshalSortableBinary and MarshalSortableText outputs, so ranges map to key ranges
+	of time-ordered keyspaces; range is empty when Lo >= Hi
+ */
+
+type Range struct {
+	Lo UUID
+	Hi UUID
+}
+
+/**
+	Creates range of Time-based UUIDs for the half-open time interval [start, end) by RangeForInterval
+ */
+
+func NewRange(start, end time.Time) Range {
+	lo, hi := RangeForInterval(start, end)
+	return Range{ Lo: lo, Hi: hi }
+}
+
+/**
+	Checks if range is empty
+ */
+
+func (this Range) IsEmpty() bool {
+	return CompareTimeuuid(this.Lo, this.Hi) >= 0
+}
+
+/**
+	Checks if UUID is in the range
+ */
+
+func (this Range) Contains(uuid UUID) bool {
+	return CompareTimeuuid(this.Lo, uuid) <= 0 && CompareTimeuuid(uuid, this.Hi) < 0
+}
+
+/**
+	Checks if ranges have at least one common UUID
+ */
+
+func (this Range) Overlaps(other Range) bool {
+	_, ok := this.Intersect(other)
+	return ok
+}
+
+/**
+	Gets common part of ranges
+
+	return false if the common part is empty
+ */
+
+func (this Range) Intersect(other Range) (Range, bool) {
+	r := this
+	if CompareTimeuuid(other.Lo, r.Lo) > 0 {
+		r.Lo = other.Lo
+	}
+	if CompareTimeuuid(other.Hi, r.Hi) < 0 {
+		r.Hi = other.Hi
+	}
+	return r, !r.IsEmpty()
+}
+
+/**
+	Gets iterator over adjacent sub-ranges that cover the range, each sub-range takes the time step
+
+	The first sub-range starts at Lo, the last sub-range ends at Hi and could be shorter than the step,
+	steps less than 100 nanoseconds yield the whole range; empty range yields nothing
+
+	for r := range rng.Steps(time.Hour) { ... }
+ */
+
+func (this Range) Steps(step time.Duration) iter.Seq[Range] {
+	return func(yield func(Range) bool) {
+
+		ticks := uint64(step / 100)
+		if step < 100 {
+			ticks = 0
+		}
+
+		lo := this.Lo
+		for CompareTimeuuid(lo, this.Hi) < 0 {
+
+			hi := this.Hi
+			time100Nanos := lo.Time100NanosUnsigned()
+			if ticks > 0 && time100Nanos + ticks <= maxTime100Nanos {
+				next := NewUUID(TimebasedVer1)
+				next.SetTime100NanosUnsigned(time100Nanos + ticks)
+				next.SetMinCounter()
+				if CompareTimeuuid(next, hi) < 0 {
+					hi = next
+				}
+			}
+
+			if !yield(Range{ Lo: lo, Hi: hi }) {
+				return
+			}
+			lo = hi
+		}
+	}
+}
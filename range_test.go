@@ -0,0 +1,97 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	at := func(d time.Duration, counter int64) UUID {
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetTime(start.Add(d))
+		uuid.SetCounter(counter)
+		return uuid
+	}
+
+	day := NewRange(start, start.Add(24 * time.Hour))
+	assert.False(t, day.IsEmpty())
+	assert.True(t, day.Contains(at(0, 0)))
+	assert.True(t, day.Contains(at(time.Hour, 0x3fffffffffffffff)))
+	assert.False(t, day.Contains(at(24 * time.Hour, 0)))
+	assert.False(t, day.Contains(at(-100, 0x3fffffffffffffff)))
+
+	// intersect
+
+	evening := NewRange(start.Add(18 * time.Hour), start.Add(30 * time.Hour))
+	assert.True(t, day.Overlaps(evening))
+	assert.True(t, evening.Overlaps(day))
+
+	common, ok := day.Intersect(evening)
+	assert.True(t, ok)
+	assert.Equal(t, NewRange(start.Add(18 * time.Hour), start.Add(24 * time.Hour)), common)
+
+	nextDay := NewRange(start.Add(24 * time.Hour), start.Add(48 * time.Hour))
+	assert.False(t, day.Overlaps(nextDay))
+	_, ok = day.Intersect(nextDay)
+	assert.False(t, ok)
+
+	empty := NewRange(start, start)
+	assert.True(t, empty.IsEmpty())
+	assert.False(t, empty.Contains(at(0, 0)))
+	assert.False(t, day.Overlaps(empty))
+
+	// steps
+
+	var steps []Range
+	for r := range NewRange(start, start.Add(150 * time.Minute)).Steps(time.Hour) {
+		steps = append(steps, r)
+	}
+	assert.Equal(t, []Range{
+		NewRange(start, start.Add(time.Hour)),
+		NewRange(start.Add(time.Hour), start.Add(2 * time.Hour)),
+		NewRange(start.Add(2 * time.Hour), start.Add(150 * time.Minute)),
+	}, steps)
+
+	steps = steps[:0]
+	for r := range day.Steps(0) {
+		steps = append(steps, r)
+	}
+	assert.Equal(t, []Range{ day }, steps)
+
+	steps = steps[:0]
+	for r := range day.Steps(time.Hour) {
+		steps = append(steps, r)
+		if len(steps) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, len(steps))
+
+	for range empty.Steps(time.Hour) {
+		assert.Fail(t, "empty range")
+	}
+
+}
@@ -0,0 +1,147 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+var (
+	ErrorRateLimited = errors.New("UUID generation rate limit exceeded")
+)
+
+/**
+	Rate limit error with the delay after which the next UUID is available
+
+	Matches ErrorRateLimited in errors.Is, use errors.As to get the delay
+ */
+
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (this *RateLimitError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrorRateLimited.Error(), this.RetryAfter)
+}
+
+func (this *RateLimitError) Is(target error) bool {
+	return target == ErrorRateLimited
+}
+
+/**
+	Generator decorator issuing at most rate UUIDs per second with bursts up to burst UUIDs
+
+	Protects downstream systems from ID-generation storms in retry loops; by default returns
+	RateLimitError when the limit is exceeded, SetBlocking makes Next wait for the next slot instead
+ */
+
+type RateLimitedGenerator struct {
+	mutex    sync.Mutex
+	gen      Generator
+	clock    func() time.Time
+	sleep    func(time.Duration)
+	interval time.Duration
+	burst    time.Duration
+	blocking bool
+	// theoretical arrival time of the next UUID in GCRA
+	arrival  time.Time
+}
+
+/**
+	Creates rate limited generator over gen
+
+	Rate is the number of UUIDs per second, non-positive rate disables the limit;
+	burst less than 1 is treated as 1
+ */
+
+func NewRateLimitedGenerator(gen Generator, rate float64, burst int) *RateLimitedGenerator {
+	if burst < 1 {
+		burst = 1
+	}
+	var interval time.Duration
+	if rate > 0 {
+		interval = time.Duration(float64(time.Second) / rate)
+	}
+	return &RateLimitedGenerator{
+		gen:      gen,
+		clock:    time.Now,
+		sleep:    time.Sleep,
+		interval: interval,
+		burst:    interval * time.Duration(burst),
+	}
+}
+
+/**
+	Sets whether Next waits for the next slot instead of returning RateLimitError
+ */
+
+func (this *RateLimitedGenerator) SetBlocking(blocking bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.blocking = blocking
+}
+
+/**
+	Issues next UUID of the underlying generator if the rate limit allows
+ */
+
+func (this *RateLimitedGenerator) Next() (uuid UUID, err error) {
+
+	done := traceStart(TraceGenerate, "RateLimitedGenerator.Next")
+	defer func() { done(err) }()
+
+	delay, ok := this.reserve()
+	if !ok {
+		return Empty, &RateLimitError{ RetryAfter: delay }
+	}
+	if delay > 0 {
+		this.sleep(delay)
+	}
+
+	return this.gen.Next()
+}
+
+/**
+	Reserves the slot of the next UUID, returns delay to wait in blocking mode,
+	or delay to retry after and false if the limit is exceeded in non-blocking mode
+ */
+
+func (this *RateLimitedGenerator) reserve() (time.Duration, bool) {
+
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+
+	now := this.clock()
+	arrival := this.arrival
+	if arrival.Before(now) {
+		arrival = now
+	}
+	arrival = arrival.Add(this.interval)
+
+	delay := arrival.Sub(now) - this.burst
+	if delay > 0 && !this.blocking {
+		return delay, false
+	}
+
+	this.arrival = arrival
+	return delay, true
+}
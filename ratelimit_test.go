@@ -0,0 +1,90 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitedGenerator(t *testing.T) {
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var slept time.Duration
+
+	gen := NewRateLimitedGenerator(NewFixtureGenerator(now), 10, 3)
+	gen.clock = func() time.Time { return now }
+	gen.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+
+	// burst
+
+	for i := 0; i != 3; i = i + 1 {
+		_, err := gen.Next()
+		assert.NoError(t, err)
+	}
+
+	_, err := gen.Next()
+	assert.True(t, errors.Is(err, ErrorRateLimited))
+	var rateLimitErr *RateLimitError
+	assert.True(t, errors.As(err, &rateLimitErr))
+	assert.Equal(t, 100 * time.Millisecond, rateLimitErr.RetryAfter)
+
+	// refill
+
+	now = now.Add(50 * time.Millisecond)
+	_, err = gen.Next()
+	assert.Error(t, err)
+
+	now = now.Add(50 * time.Millisecond)
+	_, err = gen.Next()
+	assert.NoError(t, err)
+
+	_, err = gen.Next()
+	assert.Error(t, err)
+
+	now = now.Add(time.Hour)
+	for i := 0; i != 3; i = i + 1 {
+		_, err := gen.Next()
+		assert.NoError(t, err)
+	}
+
+	// blocking waits for the reserved slots
+
+	gen.SetBlocking(true)
+	for i := 0; i != 5; i = i + 1 {
+		_, err := gen.Next()
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 500 * time.Millisecond, slept)
+
+	// unlimited
+
+	unlimited := NewRateLimitedGenerator(NewFixtureGenerator(now), 0, 0)
+	for i := 0; i != 1000; i = i + 1 {
+		_, err := unlimited.Next()
+		assert.NoError(t, err)
+	}
+
+}
@@ -0,0 +1,231 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+/**
+	Position of the batch re-encoding that allows to resume it after restart
+ */
+
+type Checkpoint struct {
+
+	/**
+		Number of records converted
+	 */
+
+	Records int64 `json:"records"`
+
+	/**
+		Offset in source after the last converted record
+	 */
+
+	SrcOffset int64 `json:"src_offset"`
+
+	/**
+		Offset in destination after the last converted record
+	 */
+
+	DstOffset int64 `json:"dst_offset"`
+}
+
+/**
+	Resumable batch re-encoder that converts stored UUIDs from one format to another
+ */
+
+type Reencoder struct {
+
+	/**
+		Format of source records
+	 */
+
+	From Format
+
+	/**
+		Format of destination records
+	 */
+
+	To Format
+
+	/**
+		Number of records between checkpoints, default is 10000
+	 */
+
+	CheckpointEvery int64
+
+	/**
+		Optional callback to persist checkpoint, called after destination was flushed
+	 */
+
+	OnCheckpoint func(Checkpoint) error
+
+	/**
+		Optional callback to report progress, called on every checkpoint and at the end
+	 */
+
+	OnProgress func(Checkpoint)
+}
+
+/**
+	Converts records from the source to the destination starting from the checkpoint
+
+	Use the zero Checkpoint to start from the beginning, destination must be truncated to DstOffset of the
+	checkpoint by caller when it is resumed
+
+	return last checkpoint, that is equal to the position of successfully converted records on error
+ */
+
+func (this *Reencoder) Run(src io.ReadSeeker, dst io.WriteSeeker, resume Checkpoint) (Checkpoint, error) {
+
+	if _, err := src.Seek(resume.SrcOffset, io.SeekStart); err != nil {
+		return resume, err
+	}
+	if _, err := dst.Seek(resume.DstOffset, io.SeekStart); err != nil {
+		return resume, err
+	}
+
+	every := this.CheckpointEvery
+	if every <= 0 {
+		every = 10000
+	}
+
+	decoder := NewDecoder(src, this.From)
+	encoder := NewEncoder(dst, this.To)
+	last := resume
+
+	commit := func(records int64) error {
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		last = Checkpoint{
+			Records:   records,
+			SrcOffset: resume.SrcOffset + decoder.Offset(),
+			DstOffset: resume.DstOffset + encoder.Offset(),
+		}
+		if this.OnCheckpoint != nil {
+			if err := this.OnCheckpoint(last); err != nil {
+				return err
+			}
+		}
+		if this.OnProgress != nil {
+			this.OnProgress(last)
+		}
+		return nil
+	}
+
+	records := resume.Records
+	for {
+
+		uuid, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return last, err
+		}
+
+		if err := encoder.Encode(uuid); err != nil {
+			return last, err
+		}
+
+		records++
+		if (records - resume.Records) % every == 0 {
+			if err := commit(records); err != nil {
+				return last, err
+			}
+		}
+	}
+
+	if err := commit(records); err != nil {
+		return last, err
+	}
+	return last, nil
+}
+
+/**
+	Converts file with records from one format to another
+
+	Checkpoint is stored in JSON file at checkpointPath and the conversion resumes from it after restart,
+	the checkpoint file is removed after successful completion
+ */
+
+func (this *Reencoder) RunFile(srcPath, dstPath, checkpointPath string) (Checkpoint, error) {
+
+	var resume Checkpoint
+	if data, err := os.ReadFile(checkpointPath); err == nil {
+		if err := json.Unmarshal(data, &resume); err != nil {
+			return resume, err
+		}
+	} else if !os.IsNotExist(err) {
+		return resume, err
+	}
+
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return resume, err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(dstPath, os.O_RDWR | os.O_CREATE, 0644)
+	if err != nil {
+		return resume, err
+	}
+	defer dst.Close()
+
+	if err := dst.Truncate(resume.DstOffset); err != nil {
+		return resume, err
+	}
+
+	reencoder := *this
+	reencoder.OnCheckpoint = func(checkpoint Checkpoint) error {
+		if err := dst.Sync(); err != nil {
+			return err
+		}
+		if err := saveCheckpoint(checkpointPath, checkpoint); err != nil {
+			return err
+		}
+		if this.OnCheckpoint != nil {
+			return this.OnCheckpoint(checkpoint)
+		}
+		return nil
+	}
+
+	last, err := reencoder.Run(src, dst, resume)
+	if err != nil {
+		return last, err
+	}
+
+	return last, os.Remove(checkpointPath)
+}
+
+func saveCheckpoint(path string, checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
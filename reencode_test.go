@@ -0,0 +1,115 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReencoder(t *testing.T) {
+
+	dir, err := os.MkdirTemp("", "reencode")
+	if err != nil {
+		t.Fatal("fail to create temp dir ", err)
+	}
+	defer os.RemoveAll(dir)
+
+	values := make([]UUID, 1000)
+	src := new(bytes.Buffer)
+	encoder := NewEncoder(src, CanonicalFormat)
+	for i := range values {
+		values[i] = NewUUID(TimebasedVer1)
+		values[i].SetUnixTimeMillis(rand.Int63n(1 << 40))
+		values[i].SetCounter(rand.Int63())
+		encoder.Encode(values[i])
+	}
+	encoder.Flush()
+
+	srcPath := filepath.Join(dir, "src.txt")
+	dstPath := filepath.Join(dir, "dst.bin")
+	checkpointPath := filepath.Join(dir, "checkpoint.json")
+
+	if err := os.WriteFile(srcPath, src.Bytes(), 0644); err != nil {
+		t.Fatal("fail to write source ", err)
+	}
+
+	// interrupt in the middle of conversion
+
+	errInterrupted := errors.New("interrupted")
+
+	var progress []Checkpoint
+	reencoder := &Reencoder{
+		From: CanonicalFormat,
+		To: SortableBinaryFormat,
+		CheckpointEvery: 300,
+		OnProgress: func(checkpoint Checkpoint) {
+			progress = append(progress, checkpoint)
+		},
+		OnCheckpoint: func(checkpoint Checkpoint) error {
+			if checkpoint.Records == 600 {
+				return errInterrupted
+			}
+			return nil
+		},
+	}
+
+	last, err := reencoder.RunFile(srcPath, dstPath, checkpointPath)
+	assert.Equal(t, errInterrupted, err)
+	assert.Equal(t, int64(600), last.Records)
+	assert.Equal(t, 1, len(progress))
+
+	// garbage written after the checkpoint must be dropped on resume
+
+	f, _ := os.OpenFile(dstPath, os.O_APPEND | os.O_WRONLY, 0644)
+	f.Write([]byte("garbage"))
+	f.Close()
+
+	reencoder.OnCheckpoint = nil
+	last, err = reencoder.RunFile(srcPath, dstPath, checkpointPath)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), last.Records)
+	assert.Equal(t, int64(src.Len()), last.SrcOffset)
+	assert.Equal(t, int64(16 * 1000), last.DstOffset)
+	assert.Equal(t, last, progress[len(progress)-1])
+
+	_, err = os.Stat(checkpointPath)
+	assert.True(t, os.IsNotExist(err))
+
+	data, err := os.ReadFile(dstPath)
+	if err != nil {
+		t.Fatal("fail to read destination ", err)
+	}
+
+	decoder := NewDecoder(bytes.NewReader(data), SortableBinaryFormat)
+	for _, uuid := range values {
+		actual, err := decoder.Decode()
+		if err != nil {
+			t.Fatal("fail to decode ", err)
+		}
+		assert.True(t, uuid.Equal(actual))
+	}
+
+}
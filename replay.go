@@ -0,0 +1,74 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	replayReservedMask = uint64(0x3FFFFFF000000000)
+	replayOffsetLowMask = uint64(0x0000000FFFFFFFFF)
+	signBit32 = uint32(0x80000000)
+	signBit64 = uint64(0x8000000000000000)
+)
+
+var (
+	ErrorRequiredReplayKey = errors.New("required replay key UUID")
+)
+
+/**
+	Encodes Kafka (partition, offset) pair into the custom UUID (version 8)
+
+	Layout:
+
+	custom_a: 32-bit partition + high 16 bits of offset
+	custom_b: next 12 bits of offset
+	custom_c: 26 reserved zero bits + low 36 bits of offset
+
+	Sign bits are flipped, so MarshalBinary output of replay keys is ordered by partition and then by offset,
+	the same way as byte ordered keys in the store
+ */
+
+func ReplayKey(partition int32, offset int64) UUID {
+
+	p := uint64(uint32(partition) ^ signBit32)
+	o := uint64(offset) ^ signBit64
+
+	var uuid UUID
+	uuid.mostSigBits = p << 32 | (o >> 48) << 16 | uint64(CustomVer8) << 12 | (o >> 36) & 0x0FFF
+	uuid.leastSigBits = variantIETFBits | o & replayOffsetLowMask
+	return uuid
+}
+
+/**
+	Decodes Kafka (partition, offset) pair from the replay key
+ */
+
+func ParseReplayKey(uuid UUID) (partition int32, offset int64, err error) {
+
+	if uuid.Version() != CustomVer8 || uuid.Variant() != IETF || uuid.leastSigBits & replayReservedMask != 0 {
+		return 0, 0, ErrorRequiredReplayKey
+	}
+
+	p := uint32(uuid.mostSigBits >> 32) ^ signBit32
+	o := (uuid.mostSigBits >> 16 & 0xFFFF) << 48 | (uuid.mostSigBits & 0x0FFF) << 36 | uuid.leastSigBits & replayOffsetLowMask
+
+	return int32(p), int64(o ^ signBit64), nil
+}
@@ -0,0 +1,79 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayKey(t *testing.T) {
+
+	pairs := []struct {
+		partition int32
+		offset    int64
+	} {
+		{ math.MinInt32, math.MinInt64 },
+		{ -1, 0 },
+		{ 0, -1 },
+		{ 0, 0 },
+		{ 0, 1 },
+		{ 0, 0xFFFFFFFFF },
+		{ 0, 0x1000000000 },
+		{ 0, math.MaxInt64 },
+		{ 1, 0 },
+		{ 7, 123456789 },
+		{ math.MaxInt32, math.MaxInt64 },
+	}
+
+	keys := make([][]byte, len(pairs))
+	for i, pair := range pairs {
+
+		uuid := ReplayKey(pair.partition, pair.offset)
+		assert.Equal(t, CustomVer8, uuid.Version())
+		assert.Equal(t, IETF, uuid.Variant())
+
+		partition, offset, err := ParseReplayKey(uuid)
+		assert.NoError(t, err)
+		assert.Equal(t, pair.partition, partition)
+		assert.Equal(t, pair.offset, offset)
+
+		parsed, err := Parse(uuid.String())
+		assert.NoError(t, err)
+		assert.True(t, uuid.Equal(parsed))
+
+		keys[i], _ = uuid.MarshalBinary()
+	}
+
+	assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+		return string(keys[i]) < string(keys[j])
+	}))
+
+	_, _, err := ParseReplayKey(NewUUID(TimebasedVer1))
+	assert.Equal(t, ErrorRequiredReplayKey, err)
+
+	uuid := ReplayKey(1, 1)
+	uuid.leastSigBits |= 1 << 40
+	_, _, err = ParseReplayKey(uuid)
+	assert.Equal(t, ErrorRequiredReplayKey, err)
+
+}
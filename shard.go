@@ -0,0 +1,82 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"time"
+)
+
+const (
+
+	/**
+		Number of low bits of the 48-bit node reserved for the logical shard identifier
+
+		Node layout with shard: 32-bit host (with the multicast bit of random nodes) + 16-bit shard
+	 */
+
+	ShardBits = 16
+
+	shardMask = uint64(1) << ShardBits - 1
+)
+
+/**
+	Gets logical shard identifier stored in the low 16 bits of the node
+
+	Used for Time-based UUID (version 1) and Reordered Time-based UUID (version 6)
+ */
+
+func (this UUID) Shard() uint16 {
+	return uint16(this.leastSigBits & shardMask)
+}
+
+/**
+	Stores logical shard identifier in the low 16 bits of the node, high 32 bits of the node are kept
+
+	Used for Time-based UUID (version 1) and Reordered Time-based UUID (version 6)
+ */
+
+func (this *UUID) SetShard(shard uint16) {
+	this.leastSigBits = this.leastSigBits &^ shardMask | uint64(shard)
+}
+
+/**
+	Composes 48-bit node of the host node and the shard, low 16 bits of the host node are replaced by the shard
+
+	Used to create TimebasedGenerator issuing UUIDs of the shard
+ */
+
+func NodeForShard(node int64, shard uint16) int64 {
+	return node & nodeMask &^ int64(shardMask) | int64(shard)
+}
+
+/**
+	Creates bounds of Time-based UUIDs (version 1) of the shard for the half-open time interval [start, end)
+
+	Like RangeForInterval with the shard stored in both bounds: all UUIDs of the shard in the interval are
+	lo <= uuid < hi in CompareTimeuuid order and bounds are exact at the edges of the interval;
+	UUIDs of other shards issued in the interval are also in the range, so range predicates are combined
+	with the shard or the shard is the part of the partition key
+ */
+
+func ShardRangeForInterval(shard uint16, start, end time.Time) (lo, hi UUID) {
+	lo, hi = RangeForInterval(start, end)
+	lo.SetShard(shard)
+	hi.SetShard(shard)
+	return lo, hi
+}
@@ -0,0 +1,72 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShard(t *testing.T) {
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetNode(0x0123456789AB)
+	uuid.SetClockSequence(0x1234)
+	uuid.SetShard(0xBEEF)
+	assert.Equal(t, uint16(0xBEEF), uuid.Shard())
+	assert.Equal(t, int64(0x01234567BEEF), uuid.Node())
+	assert.Equal(t, 0x1234, uuid.ClockSequence())
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+
+	assert.Equal(t, int64(0x01234567BEEF), NodeForShard(0x0123456789AB, 0xBEEF))
+	assert.Equal(t, int64(0x000000000001), NodeForShard(-1 &^ nodeMask, 1))
+
+	gen := NewTimebasedGenerator(NodeForShard(0x0123456789AB, 7), 0)
+	issued, err := gen.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(7), issued.Shard())
+
+	// bounds of the shard
+
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	end := start.Add(time.Second)
+	lo, hi := ShardRangeForInterval(7, start, end)
+	assert.Equal(t, uint16(7), lo.Shard())
+	assert.Equal(t, uint16(7), hi.Shard())
+
+	for _, node := range []int64{ 0, 0x0123456789AB, nodeMask } {
+		for _, clockSequence := range []int{ 0, 0x2000, clockSequenceBits } {
+			for _, at := range []time.Time{ start, start.Add(time.Millisecond), end.Add(-100) } {
+				uuid := UUIDAtWithCounter(at, 0)
+				uuid.SetClockSequence(clockSequence)
+				uuid.SetNode(NodeForShard(node, 7))
+				assert.True(t, CompareTimeuuid(lo, uuid) <= 0, uuid.String())
+				assert.True(t, CompareTimeuuid(uuid, hi) < 0, uuid.String())
+			}
+			uuid := UUIDAtWithCounter(end, 0)
+			uuid.SetClockSequence(clockSequence)
+			uuid.SetNode(NodeForShard(node, 7))
+			assert.True(t, CompareTimeuuid(uuid, hi) >= 0, uuid.String())
+		}
+	}
+
+}
@@ -0,0 +1,107 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+/**
+	Gets the smallest UUID in the Compare order
+
+	return false for empty input
+ */
+
+func MinOf(uuids ...UUID) (UUID, bool) {
+	if len(uuids) == 0 {
+		return Empty, false
+	}
+	min := uuids[0]
+	for _, uuid := range uuids[1:] {
+		if uuid.Less(min) {
+			min = uuid
+		}
+	}
+	return min, true
+}
+
+/**
+	Gets the largest UUID in the Compare order
+
+	return false for empty input
+ */
+
+func MaxOf(uuids ...UUID) (UUID, bool) {
+	if len(uuids) == 0 {
+		return Empty, false
+	}
+	max := uuids[0]
+	for _, uuid := range uuids[1:] {
+		if max.Less(uuid) {
+			max = uuid
+		}
+	}
+	return max, true
+}
+
+/**
+	Removes duplicates in place keeping the first occurrence of each UUID and the order of the rest
+
+	return the shortened slice that shares memory with the input
+ */
+
+func Dedup(uuids []UUID) []UUID {
+
+	// sorted input needs no set
+	sorted := true
+	for i := 1; i < len(uuids) && sorted; i++ {
+		sorted = !uuids[i].Less(uuids[i-1])
+	}
+
+	if sorted {
+		j := 0
+		for i := range uuids {
+			if i == 0 || uuids[i] != uuids[j-1] {
+				uuids[j] = uuids[i]
+				j++
+			}
+		}
+		return uuids[:j]
+	}
+
+	seen := make(map[UUID]struct{}, len(uuids))
+	j := 0
+	for _, uuid := range uuids {
+		if _, ok := seen[uuid]; !ok {
+			seen[uuid] = struct{}{}
+			uuids[j] = uuid
+			j++
+		}
+	}
+	return uuids[:j]
+}
+
+/**
+	Checks if UUID is in the slice
+ */
+
+func Contains(uuids []UUID, uuid UUID) bool {
+	for _, u := range uuids {
+		if u == uuid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceUtilities(t *testing.T) {
+
+	a := CreateUUID(1, 1)
+	b := CreateUUID(1, 2)
+	c := CreateUUID(-1, 0)
+
+	min, ok := MinOf(b, c, a)
+	assert.True(t, ok)
+	assert.Equal(t, a, min)
+
+	max, ok := MaxOf(b, a, c)
+	assert.True(t, ok)
+	assert.Equal(t, c, max)
+
+	_, ok = MinOf()
+	assert.False(t, ok)
+	_, ok = MaxOf()
+	assert.False(t, ok)
+
+	assert.True(t, Contains([]UUID{ a, b }, b))
+	assert.False(t, Contains([]UUID{ a, b }, c))
+	assert.False(t, Contains(nil, c))
+
+	assert.Equal(t, []UUID{ b, a, c }, Dedup([]UUID{ b, a, b, c, a }))
+	assert.Equal(t, []UUID{ a, b, c }, Dedup([]UUID{ a, a, b, b, b, c }))
+	assert.Equal(t, []UUID{}, Dedup([]UUID{}))
+
+	// random input
+
+	uuids := make([]UUID, 1000)
+	for i := range uuids {
+		uuids[i] = CreateUUID(rand.Int63n(10), rand.Int63n(10))
+	}
+	deduped := Dedup(append([]UUID(nil), uuids...))
+	assert.True(t, len(deduped) <= 100)
+	for _, uuid := range uuids {
+		assert.True(t, Contains(deduped, uuid))
+	}
+	Sort(deduped)
+	for i := 1; i < len(deduped); i++ {
+		assert.True(t, deduped[i-1].Less(deduped[i]))
+	}
+
+}
@@ -0,0 +1,69 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"log/slog"
+	"strconv"
+)
+
+/**
+	LogValue implements the slog.LogValuer interface.
+
+	UUID is logged as a single string attribute in canonical form
+ */
+
+func (this UUID) LogValue() slog.Value {
+	return slog.StringValue(this.String())
+}
+
+/**
+	UUID that is logged as a group of decomposed fields
+
+	Intended for debug level, like logger.Debug("issued", "id", timeuuid.Decomposed(uuid))
+ */
+
+type Decomposed UUID
+
+/**
+	LogValue implements the slog.LogValuer interface.
+
+	Time-based UUID (version 1) group includes time, clock sequence and node
+ */
+
+func (d Decomposed) LogValue() slog.Value {
+
+	uuid := UUID(d)
+
+	attrs := []slog.Attr {
+		slog.String("uuid", uuid.String()),
+		slog.String("version", uuid.Version().String()),
+		slog.String("variant", uuid.Variant().String()),
+	}
+
+	if uuid.Version() == TimebasedVer1 {
+		attrs = append(attrs,
+			slog.Time("time", uuid.Time().UTC()),
+			slog.Int("clockSequence", uuid.ClockSequence()),
+			slog.String("node", strconv.FormatInt(uuid.Node(), 16)),
+		)
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,59 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogValue(t *testing.T) {
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(123)
+	uuid.SetClockSequence(5)
+	uuid.SetNode(0xABCDEF)
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("issued", "id", uuid)
+	assert.Equal(t, `{"level":"INFO","msg":"issued","id":"` + uuid.String() + `"}` + "\n", buf.String())
+
+	buf.Reset()
+	logger.Debug("issued", "id", Decomposed(uuid))
+	assert.Equal(t, `{"level":"DEBUG","msg":"issued","id":{"uuid":"` + uuid.String() + `","version":"TimebasedVer1","variant":"IETF","time":"1970-01-01T00:00:00.123Z","clockSequence":5,"node":"abcdef"}}` + "\n", buf.String())
+
+	buf.Reset()
+	named, _ := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	logger.Debug("named", "id", Decomposed(named))
+	assert.Equal(t, `{"level":"DEBUG","msg":"named","id":{"uuid":"534b44a1-9bf1-3d20-b71e-cc4eb77c572f","version":"NamebasedVer3","variant":"IETF"}}` + "\n", buf.String())
+
+}
@@ -0,0 +1,84 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+	Snowflake: sign bit + 41-bit time in milliseconds since custom epoch + 10-bit worker id + 12-bit sequence
+ */
+
+const (
+	snowflakeTimeBits = 41
+	snowflakeWorkerBits = 10
+	snowflakeSequenceBits = 12
+	snowflakeCounterMask = int64(1) << (snowflakeWorkerBits + snowflakeSequenceBits) - 1
+)
+
+var (
+	/**
+		Default epoch of Twitter Snowflake IDs, 2010-11-04T01:42:54.657Z
+	 */
+
+	TwitterEpoch = time.UnixMilli(1288834974657)
+
+	ErrorSnowflakeTimeOverflow = errors.New("time is out of snowflake range")
+)
+
+/**
+	Converts Snowflake ID to the Time-based UUID (version 1)
+
+	Milliseconds since epoch are stored in the timestamp, worker id and sequence are stored
+	in the low 22 bits of the counter, so the conversion keeps snowflake ordering in MarshalSortableBinary
+ */
+
+func FromSnowflake(id int64, epoch time.Time) UUID {
+
+	millis := id >> (snowflakeWorkerBits + snowflakeSequenceBits)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(epoch.UnixMilli() + millis)
+	uuid.SetCounter(id & snowflakeCounterMask)
+	return uuid
+}
+
+/**
+	Converts Time-based UUID (version 1) to Snowflake ID
+
+	Best-effort conversion: sub-millisecond precision of the timestamp and counter bits above
+	the low 22 bits are dropped, so FromSnowflake(uuid.ToSnowflake(epoch), epoch) == uuid
+	only for UUIDs made by FromSnowflake
+ */
+
+func (this UUID) ToSnowflake(epoch time.Time) (int64, error) {
+
+	if this.Version() != TimebasedVer1 {
+		return 0, ErrorRequiredTimebasedUUID
+	}
+
+	millis := this.UnixTimeMillis() - epoch.UnixMilli()
+	if millis < 0 || millis >= int64(1) << snowflakeTimeBits {
+		return 0, ErrorSnowflakeTimeOverflow
+	}
+
+	return millis << (snowflakeWorkerBits + snowflakeSequenceBits) | this.Counter() & snowflakeCounterMask, nil
+}
@@ -0,0 +1,72 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnowflake(t *testing.T) {
+
+	// twitter example
+
+	id := int64(1541815603606036480)
+
+	uuid := FromSnowflake(id, TwitterEpoch)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, "2022-06-28T16:07:40.105Z", uuid.Time().UTC().Format("2006-01-02T15:04:05.000Z"))
+	assert.Equal(t, int64(378), uuid.Counter() >> 12)
+	assert.Equal(t, int64(0), uuid.Counter() & 0xFFF)
+
+	actual, err := uuid.ToSnowflake(TwitterEpoch)
+	assert.NoError(t, err)
+	assert.Equal(t, id, actual)
+
+	// ordering
+
+	prev, _ := FromSnowflake(id, TwitterEpoch).MarshalSortableBinary()
+	for _, nextId := range []int64{ id + 1, id + 1 << 12, id + 1 << 22 } {
+		next, _ := FromSnowflake(nextId, TwitterEpoch).MarshalSortableBinary()
+		assert.Equal(t, -1, bytes.Compare(prev, next))
+		prev = next
+	}
+
+	// custom epoch
+
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	uuid = FromSnowflake(1000 << 22 | 7, epoch)
+	assert.Equal(t, epoch.UnixMilli() + 1000, uuid.UnixTimeMillis())
+
+	actual, err = uuid.ToSnowflake(epoch)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000 << 22 | 7), actual)
+
+	// errors
+
+	_, err = NewUUID(RandomlyGeneratedVer4).ToSnowflake(epoch)
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	_, err = uuid.ToSnowflake(epoch.Add(time.Hour))
+	assert.Equal(t, ErrorSnowflakeTimeOverflow, err)
+
+}
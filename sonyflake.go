@@ -0,0 +1,95 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+	Sonyflake: 39-bit time in 10 milliseconds units since start time + 8-bit sequence + 16-bit machine id
+ */
+
+const (
+	sonyflakeTimeBits = 39
+	sonyflakeSequenceBits = 8
+	sonyflakeMachineBits = 16
+	sonyflakeCounterMask = uint64(1) << (sonyflakeSequenceBits + sonyflakeMachineBits) - 1
+	sonyflakeTimeUnitMillis = 10
+)
+
+var (
+	/**
+		Default start time of Sonyflake IDs, 2014-09-01T00:00:00Z
+	 */
+
+	SonyflakeStartTime = time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	ErrorSonyflakeTimeOverflow = errors.New("time is out of sonyflake range")
+)
+
+/**
+	Converts Sonyflake ID to the Time-based UUID (version 1)
+
+	Time since start time is stored in the timestamp, sequence and machine id are stored
+	in the low 24 bits of the counter, so the conversion keeps sonyflake ordering in MarshalSortableBinary
+ */
+
+func FromSonyflake(id uint64, startTime time.Time) UUID {
+
+	millis := int64(id >> (sonyflakeSequenceBits + sonyflakeMachineBits)) * sonyflakeTimeUnitMillis
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(startTime.UnixMilli() + millis)
+	uuid.SetCounterUnsigned(id & sonyflakeCounterMask)
+	return uuid
+}
+
+/**
+	Converts Time-based UUID (version 1) to Sonyflake ID
+
+	Best-effort conversion: time is truncated to 10 milliseconds and counter bits above
+	the low 24 bits are dropped, so FromSonyflake(uuid.ToSonyflake(startTime), startTime) == uuid
+	only for UUIDs made by FromSonyflake
+ */
+
+func (this UUID) ToSonyflake(startTime time.Time) (uint64, error) {
+
+	if this.Version() != TimebasedVer1 {
+		return 0, ErrorRequiredTimebasedUUID
+	}
+
+	millis := this.UnixTimeMillis() - startTime.UnixMilli()
+	if millis < 0 || millis / sonyflakeTimeUnitMillis >= int64(1) << sonyflakeTimeBits {
+		return 0, ErrorSonyflakeTimeOverflow
+	}
+
+	elapsed := uint64(millis / sonyflakeTimeUnitMillis)
+	return elapsed << (sonyflakeSequenceBits + sonyflakeMachineBits) | this.CounterUnsigned() & sonyflakeCounterMask, nil
+}
+
+/**
+	Gets sequence and machine id of the UUID made by FromSonyflake
+ */
+
+func (this UUID) SonyflakeParts() (sequence int, machineID int) {
+	counter := this.CounterUnsigned()
+	return int(counter >> sonyflakeMachineBits) & 0xFF, int(counter) & 0xFFFF
+}
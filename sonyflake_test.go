@@ -0,0 +1,70 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSonyflake(t *testing.T) {
+
+	elapsed := uint64(123456789)
+	id := elapsed << 24 | 5 << 16 | 0xBEEF
+
+	uuid := FromSonyflake(id, SonyflakeStartTime)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, SonyflakeStartTime.Add(time.Duration(elapsed) * 10 * time.Millisecond), uuid.Time().UTC())
+
+	sequence, machineID := uuid.SonyflakeParts()
+	assert.Equal(t, 5, sequence)
+	assert.Equal(t, 0xBEEF, machineID)
+
+	actual, err := uuid.ToSonyflake(SonyflakeStartTime)
+	assert.NoError(t, err)
+	assert.Equal(t, id, actual)
+
+	// ordering
+
+	prev, _ := FromSonyflake(id, SonyflakeStartTime).MarshalSortableBinary()
+	for _, nextId := range []uint64{ id + 1, id + 1 << 16, id + 1 << 24 } {
+		next, _ := FromSonyflake(nextId, SonyflakeStartTime).MarshalSortableBinary()
+		assert.Equal(t, -1, bytes.Compare(prev, next))
+		prev = next
+	}
+
+	// time is truncated to 10 milliseconds
+
+	uuid.SetUnixTimeMillis(SonyflakeStartTime.UnixMilli() + 1239)
+	actual, err = uuid.ToSonyflake(SonyflakeStartTime)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(123), actual >> 24)
+
+	// errors
+
+	_, err = NewUUID(RandomlyGeneratedVer4).ToSonyflake(SonyflakeStartTime)
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	_, err = uuid.ToSonyflake(time.Now())
+	assert.Equal(t, ErrorSonyflakeTimeOverflow, err)
+
+}
@@ -0,0 +1,57 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"slices"
+)
+
+/**
+	Slice of UUIDs that implements sort.Interface in the Compare order
+ */
+
+type UUIDSlice []UUID
+
+func (this UUIDSlice) Len() int {
+	return len(this)
+}
+
+func (this UUIDSlice) Less(i, j int) bool {
+	return this[i].Less(this[j])
+}
+
+func (this UUIDSlice) Swap(i, j int) {
+	this[i], this[j] = this[j], this[i]
+}
+
+/**
+	Sorts UUIDs in place in the Compare order, the order of canonical bytes
+ */
+
+func Sort(uuids []UUID) {
+	slices.SortFunc(uuids, UUID.Compare)
+}
+
+/**
+	Sorts UUIDs in place in the CompareByTime order, by embedded timestamp and then by canonical bytes
+ */
+
+func SortByTime(uuids []UUID) {
+	slices.SortFunc(uuids, UUID.CompareByTime)
+}
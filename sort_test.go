@@ -0,0 +1,61 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+
+	uuids := make([]UUID, 100)
+	for i := range uuids {
+		uuids[i] = CreateUUID(rand.Int63() - rand.Int63(), rand.Int63() - rand.Int63())
+	}
+
+	sorted := slices.Clone(uuids)
+	Sort(sorted)
+	assert.True(t, slices.IsSortedFunc(sorted, UUID.Compare))
+
+	byInterface := slices.Clone(uuids)
+	sort.Sort(UUIDSlice(byInterface))
+	assert.Equal(t, sorted, byInterface)
+	assert.True(t, sort.IsSorted(UUIDSlice(byInterface)))
+
+	// by time
+
+	now := time.Now()
+	for i := range uuids {
+		uuids[i] = NewUUID(TimebasedVer1)
+		uuids[i].SetTime(now.Add(time.Duration(rand.Int63n(int64(time.Hour)))))
+		uuids[i].SetCounter(rand.Int63())
+	}
+
+	SortByTime(uuids)
+	for i := 1; i < len(uuids); i++ {
+		assert.False(t, uuids[i].Time().Before(uuids[i-1].Time()))
+	}
+
+}
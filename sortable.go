@@ -0,0 +1,124 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+     Stores UUID in to 33 characters whose byte order matches MarshalSortableBinary
+
+     Used only for Time-based UUID
+
+     Result is lower case hex of the sortable binary with dash between msb and lsb:
+
+     xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx
+
+     Used as object-store keys and file names sorted chronologically as strings
+ */
+
+func (this UUID) MarshalSortableText() ([]byte, error) {
+	dst := make([]byte, 33)
+	err := this.MarshalSortableTextTo(dst)
+	return dst, err
+}
+
+/**
+     Stores sortable text of UUID in to preallocated slice
+
+     Used only for Time-based UUID
+ */
+
+func (this UUID) MarshalSortableTextTo(dst []byte) error {
+
+	if len(dst) < 33 {
+		return ErrorWrongLen
+	}
+
+	var data [16]byte
+	if err := this.MarshalSortableBinaryTo(data[:]); err != nil {
+		return err
+	}
+
+	encodeHex(dst, data[:8])
+	dst[16] = '-'
+	encodeHex(dst[17:33], data[8:])
+	return nil
+}
+
+/**
+     Convert sortable text representation to UUID
+
+     Used only for Time-based UUID
+ */
+
+func (this *UUID) UnmarshalSortableText(data []byte) error {
+
+	if len(data) != 33 {
+		return ErrorWrongLen
+	}
+
+	if data[16] != '-' {
+		return errors.Errorf("invalid sortable text format: %q", data)
+	}
+
+	var bin [16]byte
+	if pos := decodeHex(bin[:8], data[:16]); pos >= 0 {
+		return invalidFormat(data, pos, fmt.Sprintf("invalid hex character %q", data[pos]))
+	}
+	if pos := decodeHex(bin[8:], data[17:]); pos >= 0 {
+		return invalidFormat(data, 17 + pos, fmt.Sprintf("invalid hex character %q", data[17 + pos]))
+	}
+
+	return this.UnmarshalSortableBinary(bin[:])
+}
+
+/**
+     Gets the flipped timestamp block of the sortable binary: 4-bit version + 60-bit timestamp in 100 nanos
+
+     Used only for Time-based UUID
+
+     The same 8 bytes as the beginning of MarshalSortableBinary output, used to build secondary
+     indexes on the time component without storing full UUIDs
+ */
+
+func (this UUID) SortableTimePrefix() (dst [8]byte) {
+	binary.BigEndian.PutUint16(dst[:], uint16(this.mostSigBits))
+	binary.BigEndian.PutUint16(dst[2:], uint16(this.mostSigBits >> 16))
+	binary.BigEndian.PutUint32(dst[4:], uint32(this.mostSigBits >> 32))
+	return dst
+}
+
+/**
+     Gets 8-byte sortable key prefix of Time-based UUID for the specific time
+
+     Used to build Seek() range scans in RocksDB/Badger keyed by MarshalSortableBinary output:
+     all keys of UUIDs at time t or later are greater or equal to the prefix
+ */
+
+func SortablePrefixForTime(t time.Time) []byte {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(t)
+	prefix := uuid.SortableTimePrefix()
+	return prefix[:]
+}
@@ -0,0 +1,125 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortableText(t *testing.T) {
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(0)
+	uuid.SetCounter(0)
+
+	data, err := uuid.MarshalSortableText()
+	assert.NoError(t, err)
+	assert.Equal(t, "11b21dd213814000-8000000000000000", string(data))
+	assert.Equal(t, SortableTextFormat, DetectFormat(string(data)))
+
+	var actual UUID
+	assert.NoError(t, actual.UnmarshalSortableText(data))
+	assert.True(t, uuid.Equal(actual))
+
+	// string order matches sortable binary order
+
+	texts := make([]string, 1000)
+	binaries := make([][]byte, len(texts))
+	for i := range texts {
+		uuid.SetUnixTimeMillis(rand.Int63n(1 << 42))
+		uuid.SetCounter(rand.Int63())
+		data, _ := uuid.MarshalSortableText()
+		texts[i] = string(data)
+		binaries[i], _ = uuid.MarshalSortableBinary()
+	}
+
+	sort.Strings(texts)
+	sort.Slice(binaries, func(i, j int) bool {
+		return bytes.Compare(binaries[i], binaries[j]) < 0
+	})
+
+	for i := range texts {
+		assert.NoError(t, actual.UnmarshalSortableText([]byte(texts[i])))
+		expected, _ := actual.MarshalSortableBinary()
+		assert.Equal(t, binaries[i], expected)
+	}
+
+	// errors
+
+	_, err = NewUUID(RandomlyGeneratedVer4).MarshalSortableText()
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	assert.Equal(t, ErrorWrongLen, actual.UnmarshalSortableText([]byte("11b21dd213814000")))
+	assert.Error(t, actual.UnmarshalSortableText([]byte("11b21dd213814000+8080808080808080")))
+	assert.Error(t, actual.UnmarshalSortableText([]byte("11b21dd21381400z-8080808080808080")))
+	assert.Error(t, actual.UnmarshalSortableText([]byte("11b21dd213814000-808080808080808z")))
+	assert.Equal(t, ErrorRequiredTimebasedUUID, actual.UnmarshalSortableText([]byte("41b21dd213814000-8080808080808080")))
+
+}
+
+func TestSortableTimePrefix(t *testing.T) {
+
+	uuid := NewUUID(TimebasedVer1)
+
+	for i := 0; i != 100; i = i + 1 {
+		uuid.SetUnixTimeMillis(rand.Int63n(1 << 42))
+		uuid.SetCounter(rand.Int63())
+		data, _ := uuid.MarshalSortableBinary()
+		prefix := uuid.SortableTimePrefix()
+		assert.Equal(t, data[:8], prefix[:])
+	}
+
+	uuid.SetUnixTimeMillis(0)
+	prefix := uuid.SortableTimePrefix()
+	assert.Equal(t, []byte { 0x11, 0xb2, 0x1d, 0xd2, 0x13, 0x81, 0x40, 0x00 }, prefix[:])
+
+}
+
+func TestSortablePrefixForTime(t *testing.T) {
+
+	start := time.Unix(1600000000, 0)
+	prefix := SortablePrefixForTime(start)
+	assert.Equal(t, 8, len(prefix))
+
+	uuid := NewUUID(TimebasedVer1)
+	for i := 0; i != 100; i = i + 1 {
+
+		uuid.SetTime(start.Add(time.Duration(rand.Int63n(int64(time.Hour))) - 30 * time.Minute))
+		uuid.SetCounter(rand.Int63())
+		key, _ := uuid.MarshalSortableBinary()
+
+		if uuid.Time().Before(start) {
+			assert.True(t, bytes.Compare(key, prefix) < 0)
+		} else {
+			assert.True(t, bytes.Compare(key, prefix) >= 0)
+		}
+	}
+
+	uuid.SetTime(start)
+	uuid.SetMinCounter()
+	key, _ := uuid.MarshalSortableBinary()
+	assert.Equal(t, prefix, key[:8])
+
+}
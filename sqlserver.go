@@ -0,0 +1,52 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"math/bits"
+)
+
+/**
+	Compares two UUIDs in the same order as SQL Server compares uniqueidentifier values
+
+	SQL Server compares byte groups from the last one: bytes 10-15, then 8-9, then 6-7, 4-5 and 0-3,
+	where 0-3, 4-5 and 6-7 are stored in little-endian order, so in the canonical byte order
+	significance is 10, 11, 12, 13, 14, 15, 8, 9, 7, 6, 5, 4, 3, 2, 1, 0
+
+	return -1 if a < b, 0 if a == b, +1 if a > b
+ */
+
+func CompareSQLServer(a, b UUID) int {
+
+	aHigh, bHigh := a.leastSigBits << 16 | a.leastSigBits >> 48, b.leastSigBits << 16 | b.leastSigBits >> 48
+	aLow, bLow := bits.ReverseBytes64(a.mostSigBits), bits.ReverseBytes64(b.mostSigBits)
+
+	switch {
+	case aHigh < bHigh:
+		return -1
+	case aHigh > bHigh:
+		return 1
+	case aLow < bLow:
+		return -1
+	case aLow > bLow:
+		return 1
+	default:
+		return 0
+	}
+}
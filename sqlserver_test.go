@@ -0,0 +1,63 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSQLServer(t *testing.T) {
+
+	// ORDER BY of the uniqueidentifier with a single byte set, from the least to the most significant
+
+	order := []int{ 0, 1, 2, 3, 4, 5, 6, 7, 9, 8, 15, 14, 13, 12, 11, 10 }
+
+	var prev UUID
+	for _, i := range order {
+		var data [16]byte
+		data[i] = 1
+		next := FromArray(data)
+		assert.Equal(t, -1, CompareSQLServer(prev, next), i)
+		assert.Equal(t, 1, CompareSQLServer(next, prev), i)
+		assert.Equal(t, 0, CompareSQLServer(next, next), i)
+		prev = next
+	}
+
+	// COMB GUIDs are ordered by time
+
+	gen := NewCombGenerator()
+	now := time.Now()
+	uuids := make([]UUID, 100)
+	for i := range uuids {
+		gen.clock = func() time.Time { return now.Add(time.Duration(i) * time.Millisecond) }
+		uuids[i], _ = gen.Next()
+	}
+
+	sorted := make([]UUID, len(uuids))
+	copy(sorted, uuids)
+	sort.Slice(sorted, func(i, j int) bool {
+		return CompareSQLServer(sorted[i], sorted[j]) < 0
+	})
+	assert.Equal(t, uuids, sorted)
+
+}
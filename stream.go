@@ -0,0 +1,162 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"github.com/pkg/errors"
+)
+
+/**
+	Streaming encoder of UUIDs
+
+	Binary formats are written as fixed 16-byte records, text formats as newline-delimited records
+ */
+
+type Encoder struct {
+	writer  *bufio.Writer
+	format  Format
+	buf     []byte
+	offset  int64
+}
+
+/**
+	Creates new streaming encoder
+ */
+
+func NewEncoder(w io.Writer, format Format) *Encoder {
+	return &Encoder{
+		writer: bufio.NewWriter(w),
+		format: format,
+		buf:    make([]byte, 0, 64),
+	}
+}
+
+/**
+	Writes single record
+ */
+
+func (this *Encoder) Encode(uuid UUID) error {
+	var err error
+	this.buf, err = uuid.AppendFormat(this.buf[:0], this.format)
+	if err != nil {
+		return err
+	}
+	if !this.format.Binary() {
+		this.buf = append(this.buf, '\n')
+	}
+	n, err := this.writer.Write(this.buf)
+	this.offset += int64(n)
+	return err
+}
+
+/**
+	Writes buffered records to the underlying writer
+ */
+
+func (this *Encoder) Flush() error {
+	return this.writer.Flush()
+}
+
+/**
+	Gets number of bytes written by encoder including buffered ones
+ */
+
+func (this *Encoder) Offset() int64 {
+	return this.offset
+}
+
+/**
+	Streaming decoder of UUIDs
+
+	Binary formats are read as fixed 16-byte records, text formats as newline-delimited records,
+	surrounding whitespace and empty lines are ignored
+ */
+
+type Decoder struct {
+	reader  *bufio.Reader
+	format  Format
+	record  [16]byte
+	offset  int64
+}
+
+/**
+	Creates new streaming decoder
+ */
+
+func NewDecoder(r io.Reader, format Format) *Decoder {
+	return &Decoder{
+		reader: bufio.NewReader(r),
+		format: format,
+	}
+}
+
+/**
+	Reads single record
+
+	return io.EOF when there are no more records
+ */
+
+func (this *Decoder) Decode() (UUID, error) {
+
+	if this.format.Binary() {
+		n, err := io.ReadFull(this.reader, this.record[:])
+		this.offset += int64(n)
+		if err == io.ErrUnexpectedEOF {
+			return Empty, errors.Wrapf(ErrorWrongLen, "truncated record at offset %d", this.offset - int64(n))
+		}
+		if err != nil {
+			return Empty, err
+		}
+		return ParseFormat(this.record[:], this.format)
+	}
+
+	for {
+
+		line, err := this.reader.ReadSlice('\n')
+		this.offset += int64(len(line))
+
+		if err == bufio.ErrBufferFull {
+			return Empty, errors.Errorf("too long record at offset %d", this.offset - int64(len(line)))
+		}
+
+		record := bytes.TrimSpace(line)
+		if len(record) > 0 {
+			uuid, parseErr := ParseFormat(record, this.format)
+			if parseErr != nil {
+				return Empty, errors.Wrapf(parseErr, "record at offset %d", this.offset - int64(len(line)))
+			}
+			return uuid, nil
+		}
+
+		if err != nil {
+			return Empty, err
+		}
+	}
+}
+
+/**
+	Gets number of bytes consumed by decoder to the end of the last record
+ */
+
+func (this *Decoder) Offset() int64 {
+	return this.offset
+}
@@ -0,0 +1,87 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+
+	values := make([]UUID, 100)
+	for i := range values {
+		values[i] = NewUUID(TimebasedVer1)
+		values[i].SetUnixTimeMillis(rand.Int63n(1 << 40))
+		values[i].SetCounter(rand.Int63())
+	}
+
+	for _, format := range []Format { CanonicalFormat, BracedFormat, URNFormat, CompactFormat, RawHexFormat, Base64Format, Base32Format, Base58Format, SortableTextFormat, BinaryFormat, SortableBinaryFormat } {
+
+		buf := new(bytes.Buffer)
+		encoder := NewEncoder(buf, format)
+		for _, uuid := range values {
+			assert.NoError(t, encoder.Encode(uuid))
+		}
+		assert.NoError(t, encoder.Flush())
+		assert.Equal(t, int64(buf.Len()), encoder.Offset())
+
+		size := int64(buf.Len())
+		decoder := NewDecoder(buf, format)
+		for _, uuid := range values {
+			actual, err := decoder.Decode()
+			if err != nil {
+				t.Fatal("fail to decode ", format, err)
+			}
+			assert.True(t, uuid.Equal(actual), format.String())
+		}
+
+		_, err := decoder.Decode()
+		assert.Equal(t, io.EOF, err)
+		assert.Equal(t, size, decoder.Offset())
+	}
+
+	// text records with whitespace, empty lines and no trailing newline
+
+	decoder := NewDecoder(strings.NewReader("\n 534b44a1-9bf1-3d20-b71e-cc4eb77c572f \r\n\n534b44a1-9bf1-3d20-b71e-cc4eb77c572f"), CanonicalFormat)
+	for i := 0; i != 2; i = i + 1 {
+		actual, err := decoder.Decode()
+		assert.NoError(t, err)
+		assert.Equal(t, "534b44a1-9bf1-3d20-b71e-cc4eb77c572f", actual.String())
+	}
+	_, err := decoder.Decode()
+	assert.Equal(t, io.EOF, err)
+
+	decoder = NewDecoder(strings.NewReader("bad\n"), CanonicalFormat)
+	_, err = decoder.Decode()
+	assert.Error(t, err)
+
+	decoder = NewDecoder(bytes.NewReader(make([]byte, 20)), BinaryFormat)
+	_, err = decoder.Decode()
+	assert.NoError(t, err)
+	_, err = decoder.Decode()
+	assert.Error(t, err)
+	assert.NotEqual(t, io.EOF, err)
+
+}
@@ -20,13 +20,13 @@ package timeuuid
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
 	"github.com/pkg/errors"
-	"crypto/md5"
 	"encoding/binary"
-	"encoding/hex"
-	"crypto/sha1"
 	"fmt"
 	"bytes"
+	"io"
+	"slices"
 	"time"
 )
 
@@ -45,6 +45,20 @@ type UUID struct {
 
 var Empty = UUID{0, 0}
 
+/**
+	Nil UUID of RFC 9562 with all bits set to zero, the same as Empty
+ */
+
+var ZeroUUID = Empty
+
+/**
+	Max UUID of RFC 9562 with all bits set to one, ffffffff-ffff-ffff-ffff-ffffffffffff
+
+    Sorts after any other UUID in the Compare order, used as the upper range sentinel
+ */
+
+var MaxUUID = UUID{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF}
+
 type Variant int
 
 // Constants returned by Variant.
@@ -62,14 +76,19 @@ const (
 
 	one100NanosInSecond       = int64(time.Second) / 100
 	one100NanosInMillis       = int64(time.Millisecond) / 100
+	one100NanosInMicros       = int64(time.Microsecond) / 100
 	num100NanosSinceUUIDEpoch = int64(0x01b21dd213814000)
 
 	versionMask          = uint64(0x000000000000F000)
 	timebasedVersionBits = uint64(0x0000000000001000)
+	unixTimebasedVersionBits = uint64(0x0000000000007000)
 	maxTimeBits          = uint64(0xFFFFFFFFFFFF0FFF)
+	maxTime100Nanos      = uint64(0x0FFFFFFFFFFFFFFF)
 
 	nodeMask      = int64(0x0000FFFFFFFFFFFF)
 	nodeClearMask = uint64(0xFFFF000000000000)
+	// multicast bit of random nodes as described in RFC 4122 4.5, never set in real MAC addresses
+	multicastNodeBit = int64(0x010000000000)
 
 	clockSequenceBits      = int(0x3FFF)
 	clockSequenceClearMask = uint64(0xC000FFFFFFFFFFFF)
@@ -97,6 +116,9 @@ const (
 	NamebasedVer3
 	RandomlyGeneratedVer4
 	NamebasedVer5
+	ReorderedTimebasedVer6
+	UnixTimebasedVer7
+	CustomVer8
 	UnknownVersion
 )
 
@@ -108,6 +130,43 @@ func (this UUID) Equal(other UUID) bool {
 	return this.mostSigBits == other.mostSigBits && this.leastSigBits == other.leastSigBits
 }
 
+/**
+	Compare two required values of UUID in constant time
+
+    Used for name-based UUIDs in authentication, so comparison of tokens is not a timing oracle
+ */
+
+func (this UUID) EqualConstantTime(other UUID) bool {
+	a, b := this.Array(), other.Array()
+	return subtle.ConstantTimeCompare(a[:], b[:]) == 1
+}
+
+/**
+	Checks if UUID equals ZeroUUID
+
+    Recognized by encoding/json omitzero option
+ */
+
+func (this UUID) IsZero() bool {
+	return this.mostSigBits == 0 && this.leastSigBits == 0
+}
+
+/**
+	Checks if UUID is the Nil UUID of RFC 9562, the same as IsZero
+ */
+
+func (this UUID) IsNil() bool {
+	return this.IsZero()
+}
+
+/**
+	Checks if UUID equals MaxUUID
+ */
+
+func (this UUID) IsMax() bool {
+	return this == MaxUUID
+}
+
 /**
 	Compare two optional values of UUID
 
@@ -185,12 +244,27 @@ func (this*UUID) SetLeastSignificantBits(leastSigBits int64) {
  */
 
 func (this UUID) MarshalBinary() (dst []byte, err error) {
+	done := traceStart(TraceMarshal, "MarshalBinary")
 	dst = make([]byte, 16)
 	err = this.MarshalBinaryTo(dst)
+	done(err)
 	return dst, err
 
 }
 
+/**
+     Appends 16 bytes of UUID to the slice
+
+     AppendBinary implements the encoding.BinaryAppender interface.
+ */
+
+func (this UUID) AppendBinary(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = slices.Grow(dst, 16)[:n + 16]
+	err := this.MarshalBinaryTo(dst[n:])
+	return dst, err
+}
+
 /**
      Stores UUID in to slice
  */
@@ -225,6 +299,33 @@ func (this*UUID) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+/**
+     Gets UUID as 16 bytes array
+
+     Array converts directly to the libraries those UUID type is an array alias, like gocql.UUID(uuid.Array())
+
+     UUID is stored as two 64-bit words, so the internal state could not be aliased,
+     but the array is returned by value without heap allocation and intermediate slices
+ */
+
+func (this UUID) Array() (dst [16]byte) {
+	binary.BigEndian.PutUint64(dst[:8], this.mostSigBits)
+	binary.BigEndian.PutUint64(dst[8:], this.leastSigBits)
+	return dst
+}
+
+/**
+     Creates UUID from 16 bytes array
+
+     Accepts the libraries those UUID type is an array alias, like FromArray(gocqlUUID)
+ */
+
+func FromArray(data [16]byte) (uuid UUID) {
+	uuid.mostSigBits = binary.BigEndian.Uint64(data[:8])
+	uuid.leastSigBits = binary.BigEndian.Uint64(data[8:])
+	return uuid
+}
+
 /**
      Stores UUID in to 16 bytes by flipping timestamp parts to make byte array sortable
 
@@ -232,8 +333,10 @@ func (this*UUID) UnmarshalBinary(data []byte) error {
  */
 
 func (this UUID) MarshalSortableBinary() ([]byte, error) {
+	done := traceStart(TraceMarshal, "MarshalSortableBinary")
 	dst := make([]byte, 16)
 	err := this.MarshalSortableBinaryTo(dst)
+	done(err)
 	return dst, err
 }
 
@@ -307,28 +410,67 @@ func (this*UUID) UnmarshalSortableBinary(data []byte) error {
 	return nil
 }
 
+const (
+	randomReadAttempts = 3
+)
+
+/**
+    Source of the random bytes for randomly generated UUIDs, replaced in tests
+ */
+
+var randomReader io.Reader = rand.Reader
+
 /**
     Generates random UUID by using pseudo-random cryptographic generator
+
+    Read of the random bytes is retried on failure, the error is returned when all attempts failed
  */
 
 func RandomUUID() (uuid UUID, err error) {
 
-	var randomBytes = make([]byte, 16)
-	rand.Read(randomBytes)
+	var randomBytes [16]byte
+	if err = readRandom(randomBytes[:]); err != nil {
+		return Empty, err
+	}
 
 	randomBytes[6]  &= 0x0f;  /* clear version        */
 	randomBytes[6]  |= 0x40;  /* set to version 4     */
 	randomBytes[8]  &= 0x3f;  /* clear variant        */
 	randomBytes[8]  |= 0x80;  /* set to IETF variant  */
 
-	err = uuid.UnmarshalBinary(randomBytes)
+	err = uuid.UnmarshalBinary(randomBytes[:])
 	return uuid, err
 
 }
 
+/**
+    Generates random UUID and panics if pseudo-random cryptographic generator fails
+
+    Used in initialization of variables and in tests
+ */
+
+func MustRandom() UUID {
+	uuid, err := RandomUUID()
+	if err != nil {
+		panic(err)
+	}
+	return uuid
+}
+
+func readRandom(dst []byte) (err error) {
+	for attempt := 0; attempt < randomReadAttempts; attempt++ {
+		if _, err = io.ReadFull(randomReader, dst); err == nil {
+			return nil
+		}
+	}
+	return errors.Wrapf(err, "fail to read random bytes after %d attempts", randomReadAttempts)
+}
+
 /**
 	Creates UUID based on digest of incoming byte array
-    Used for authentication purposes
+    Used for authentication purposes, compare values with EqualConstantTime
+
+    Digest covers only the name, use NameUUID for values compatible with RFC 4122 name spaces
  */
 
 func NameUUIDFromBytes(name []byte, version Version) (uuid UUID, err error) {
@@ -339,38 +481,21 @@ func NameUUIDFromBytes(name []byte, version Version) (uuid UUID, err error) {
 /**
 	Sets name digest of incoming byte array
     Used for authentication purposes
+
+    NamebasedVer3 uses MD5, NamebasedVer5 uses SHA-1 and CustomVer8 uses the first 128 bits
+    of SHA-256 with version and variant bits replaced as in RFC 9562 B.2
  */
 
 func (this*UUID) SetName(name []byte, version Version) error {
 
-	switch(version) {
-
-	case NamebasedVer3:
-
-		digest := md5.Sum(name)
-
-		digest[6]  &= 0x0f;  /* clear version        */
-		digest[6]  |= 0x30;  /* set to version 3     */
-		digest[8]  &= 0x3f;  /* clear variant        */
-		digest[8]  |= 0x80;  /* set to IETF variant  */
-
-		return this.UnmarshalBinary(digest[:])
-
-	case NamebasedVer5:
-
-		digest := sha1.Sum(name)
-
-		digest[6] &= 0x0f;  /* clear version        */
-		digest[6] |= 0x50;  /* set to version 5     */
-		digest[8] &= 0x3f;  /* clear variant        */
-		digest[8] |= 0x80;  /* set to IETF variant  */
-
-		return this.UnmarshalBinary(digest[:])
-
-	default:
-		return errors.Errorf("unknown namebased version: %q", version)
+	h, err := newNameHash(version)
+	if err != nil {
+		return err
 	}
 
+	h.Write(name)
+	*this = nameUUIDFromDigest(h.Sum(nil), version)
+	return nil
 }
 
 /**
@@ -416,12 +541,63 @@ func (this UUID) Variant() Variant {
 	}
 }
 
+/**
+	Sets 4-bit version of the UUID keeping the rest of bits
+
+    Does not check that the rest of bits follow the layout of the version
+ */
+
+func (this*UUID) SetVersion(version Version) {
+	sanitizedVersion := uint64(version) & 0xF
+	this.mostSigBits = (this.mostSigBits &^ versionMask) | (sanitizedVersion << 12)
+}
+
+/**
+	Sets variant of the UUID keeping the rest of bits
+
+    Variant takes 1 bit for NCSReserved, 2 bits for IETF and 3 bits for MicrosoftReserved and FutureReserved,
+    UnknownVariant keeps UUID as is
+ */
+
+func (this*UUID) SetVariant(variant Variant) {
+
+	var bits, mask uint64
+
+	switch variant {
+	case NCSReserved:
+		bits, mask = 0x00, 0x80
+	case IETF:
+		bits, mask = 0x80, 0xC0
+	case MicrosoftReserved:
+		bits, mask = 0xC0, 0xE0
+	case FutureReserved:
+		bits, mask = 0xE0, 0xE0
+	default:
+		return
+	}
+
+	this.leastSigBits = (this.leastSigBits &^ (mask << 56)) | (bits << 56)
+}
+
+/**
+	Gets the copy of UUID with IETF variant and the version, payload bits are kept as is
+
+    Used to repair UUIDs of systems that emit malformed variant or version bits
+ */
+
+func (this UUID) Canonicalize(version Version) UUID {
+	uuid := this
+	uuid.SetVersion(version)
+	uuid.SetVariant(IETF)
+	return uuid
+}
+
 /**
     Gets timestamp as 60bit int64 from Time-based UUID
 
     It is measured in 100-nanosecond units since midnight, October 15, 1582 UTC.
 
-    valid only for version 1 or 2
+    valid only for versions 1, 2, 6 and 7, see Time100NanosUnsigned
  */
 
 func (this UUID) Time100Nanos() int64 {
@@ -433,11 +609,27 @@ func (this UUID) Time100Nanos() int64 {
 
     It is measured in 100-nanosecond units since midnight, October 15, 1582 UTC.
 
-    valid only for version 1 or 2
+    Bits are read according to the version: reordered for version 6, unix milliseconds
+    and 12-bit sub-millisecond fraction for version 7, layout of version 1 for the rest;
+    version 2 loses the low 32 bits of time
+
+    valid only for versions 1, 2, 6 and 7
  */
 
 func (this UUID) Time100NanosUnsigned() uint64 {
 
+	switch this.Version() {
+
+	case ReorderedTimebasedVer6:
+		return this.mostSigBits >> 16 << 12 | this.mostSigBits & 0xFFF
+
+	case UnixTimebasedVer7:
+		millis := this.mostSigBits >> 16
+		// inverse of the scaling in SetTime100NanosUnsigned rounded up
+		subMillis := ((this.mostSigBits & subMillisFractionBits) * uint64(one100NanosInMillis) + subMillisFractionBits) >> 12
+		return millis * uint64(one100NanosInMillis) + subMillis + uint64(num100NanosSinceUUIDEpoch)
+	}
+
 	timeHigh := this.mostSigBits & 0x0FFF
 	timeMid := (this.mostSigBits >> 16) & 0xFFFF
 	timeLow := (this.mostSigBits >> 32) & 0xFFFFFFFF
@@ -447,6 +639,8 @@ func (this UUID) Time100NanosUnsigned() uint64 {
 
 /**
 	Sets 60-bit time in 100 nanoseconds since midnight, October 15, 1582 UTC.
+
+    Keeps version 6 and 7 of UUID, stamps version 1 for the rest, see SetTime100NanosUnsigned
  */
 
 func (this*UUID) SetTime100Nanos(time100Nanos int64) {
@@ -455,10 +649,32 @@ func (this*UUID) SetTime100Nanos(time100Nanos int64) {
 
 /**
 	Sets 60-bit time in 100 nanoseconds since midnight, October 15, 1582 UTC.
+
+    Bits are written according to the version of UUID: reordered for version 6, unix milliseconds
+    and 12-bit sub-millisecond fraction with about 244 nanoseconds precision for version 7,
+    time before unix epoch is stored as zero in version 7;
+    other versions are stamped with version 1 and its layout
  */
 
 func (this*UUID) SetTime100NanosUnsigned(time100Nanos uint64) {
 
+	switch this.Version() {
+
+	case ReorderedTimebasedVer6:
+		this.mostSigBits = reorderedTimebasedBits(time100Nanos & maxTime100Nanos)
+		return
+
+	case UnixTimebasedVer7:
+		unixTime100Nanos := int64(time100Nanos & maxTime100Nanos) - num100NanosSinceUUIDEpoch
+		if unixTime100Nanos < 0 {
+			unixTime100Nanos = 0
+		}
+		millis := uint64(unixTime100Nanos / one100NanosInMillis)
+		fraction := uint64(unixTime100Nanos % one100NanosInMillis) << 12 / uint64(one100NanosInMillis)
+		this.mostSigBits = millis << 16 | unixTimebasedVersionBits | fraction
+		return
+	}
+
 	bits := timebasedVersionBits
 
 	// timeLow
@@ -511,6 +727,47 @@ func (this*UUID) SetUnixTimeMillis(unixTimeMillis int64) {
 	this.SetTime100Nanos(time100Nanos)
 }
 
+/**
+	Gets timestamp in microseconds from Time-based UUID
+
+	It is measured in microsecond units in unix time since 1 Jan 1970
+ */
+
+func (this UUID) UnixTimeMicros() int64 {
+	return (this.Time100Nanos() - num100NanosSinceUUIDEpoch) / one100NanosInMicros
+}
+
+/**
+	Sets timestamp in microseconds to Time-based UUID
+
+    It is measured in microsecond units in unix time since 1 Jan 1970
+ */
+
+func (this*UUID) SetUnixTimeMicros(unixTimeMicros int64) {
+	this.SetUnixTime100Nanos(unixTimeMicros * one100NanosInMicros)
+}
+
+/**
+	Gets timestamp in nanoseconds from Time-based UUID, the last two digits are always zero
+
+	It is measured in nanosecond units in unix time since 1 Jan 1970,
+	int64 nanoseconds overflow for times out of years 1678 to 2262
+ */
+
+func (this UUID) UnixTimeNanos() int64 {
+	return (this.Time100Nanos() - num100NanosSinceUUIDEpoch) * 100
+}
+
+/**
+	Sets timestamp in nanoseconds to Time-based UUID, nanoseconds are truncated to 100-nanosecond ticks
+
+    It is measured in nanosecond units in unix time since 1 Jan 1970
+ */
+
+func (this*UUID) SetUnixTimeNanos(unixTimeNanos int64) {
+	this.SetUnixTime100Nanos(unixTimeNanos / 100)
+}
+
 /**
 	Gets timestamp in 100 nanoseconds from Time-based UUID
 
@@ -691,9 +948,22 @@ func Parse(s string) (UUID, error) {
 
 /**
    Parses bytes are a string representation of UUID
+
+   Accepts canonical, braced, URN and compact hex text, unpadded base64url of 22 characters
+   and Crockford base32 of 26 characters
  */
 
 func ParseBytes(src []byte) (UUID, error) {
+	done := traceStart(TraceParse, "ParseBytes")
+	uuid, err := parseBytes(src)
+	done(err)
+	return uuid, err
+}
+
+func parseBytes(src []byte) (UUID, error) {
+
+	// position of src in the input for error messages
+	input, offset := src, 0
 
 	for {
 
@@ -701,38 +971,63 @@ func ParseBytes(src []byte) (UUID, error) {
 
 		// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 		case 36:
-			if src[8] != '-' || src[13] != '-' || src[18] != '-' || src[23] != '-' {
-				return Empty, fmt.Errorf("invalid UUID format: %q", src)
+			for _, pos := range []int{ 8, 13, 18, 23 } {
+				if src[pos] != '-' {
+					return Empty, invalidFormat(input, offset + pos, "expected '-'")
+				}
+			}
+			var data [16]byte
+			if pos := decodeCanonicalHex(&data, src); pos >= 0 {
+				return Empty, invalidFormat(input, offset + pos, fmt.Sprintf("invalid hex character %q", src[pos]))
 			}
-			var trunc [32]byte
-			copy(trunc[:8], src[:8])
-			copy(trunc[8:12], src[9:13])
-			copy(trunc[12:16], src[14:18])
-			copy(trunc[16:20], src[19:23])
-			copy(trunc[20:], src[24:36])
-			src = trunc[:]
+			var uuid UUID
+			err := uuid.UnmarshalBinary(data[:])
+			return uuid, err
 
 			// urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 		case 36 + 9:
 			if !bytes.Equal(bytes.ToLower(src[:9]), []byte("urn:uuid:")) {
-				return Empty, fmt.Errorf("invalid urn prefix in %q", src)
+				return Empty, invalidFormat(input, offset, "invalid urn prefix")
 			}
 			src = src[9:]
+			offset += 9
 
-			// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx} or "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" or similar
+			// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
 		case 36 + 2:
+			if src[0] != '{' {
+				return Empty, invalidFormat(input, offset, "expected '{'")
+			}
+			if src[37] != '}' {
+				return Empty, invalidFormat(input, offset + 37, "expected '}'")
+			}
 			src = src[1:37]
+			offset++
 
 			// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 		case 32:
 			var data [16]byte
-			hex.Decode(data[:], src)
+			if pos := decodeHex(data[:], src); pos >= 0 {
+				return Empty, invalidFormat(input, offset + pos, fmt.Sprintf("invalid hex character %q", src[pos]))
+			}
 			var uuid UUID
 			err := uuid.UnmarshalBinary(data[:])
 			return uuid, err
 
+			// unpadded base64url
+		case 22:
+			return decodeBase64(src)
+
+			// Crockford base32
+		case 26:
+			uuid, err := decodeBase32(src)
+			if err == ErrorWrongLen {
+				// dashes are skipped, so less than 26 digits
+				return Empty, invalidLength(input)
+			}
+			return uuid, err
+
 		default:
-			return Empty, fmt.Errorf("invalid UUID length: %q", src)
+			return Empty, invalidLength(input)
 		}
 
 	}
@@ -740,6 +1035,8 @@ func ParseBytes(src []byte) (UUID, error) {
 
 /**
 	UnmarshalText implements the encoding.TextUnmarshaler interface.
+
+	Used by TOML decoders (BurntSushi/toml, pelletier/go-toml) for UUID-valued settings
  */
 
 func (this *UUID) UnmarshalText(data []byte) error {
@@ -750,11 +1047,29 @@ func (this *UUID) UnmarshalText(data []byte) error {
 
 /**
      MarshalText implements the encoding.TextMarshaler interface.
+
+     Used by TOML encoders to store UUID as the canonical string
  */
 
 func (this UUID) MarshalText() ([]byte, error) {
-	dst := make([]byte, 36)
-	err := this.MarshalTextTo(dst)
+	done := traceStart(TraceMarshal, "MarshalText")
+	dst, err := this.AppendText(make([]byte, 0, 36))
+	done(err)
+	return dst, err
+}
+
+/**
+	Appends 36 characters of the canonical text to the slice
+
+	AppendText implements the encoding.TextAppender interface.
+
+	Reused buffers with enough capacity are not reallocated: var buf [36]byte; uuid.AppendText(buf[:0])
+ */
+
+func (this UUID) AppendText(dst []byte) ([]byte, error) {
+	n := len(dst)
+	dst = slices.Grow(dst, 36)[:n + 36]
+	err := this.MarshalTextTo(dst[n:])
 	return dst, err
 }
 
@@ -768,20 +1083,11 @@ func (this UUID) MarshalTextTo(dst []byte) error {
 		return ErrorWrongLen
 	}
 
-	data, err := this.MarshalBinary()
-	if err != nil {
-		return err
-	}
-
-	hex.Encode(dst, data[:4])
+	encodeCanonicalHex(dst, this.mostSigBits, this.leastSigBits)
 	dst[8] = '-'
-	hex.Encode(dst[9:13], data[4:6])
 	dst[13] = '-'
-	hex.Encode(dst[14:18], data[6:8])
 	dst[18] = '-'
-	hex.Encode(dst[19:23], data[8:10])
 	dst[23] = '-'
-	hex.Encode(dst[24:], data[10:])
 	return nil
 }
 
@@ -794,9 +1100,11 @@ func (this *UUID) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return nil
 	}
-	// Fractional seconds are handled implicitly by Parse.
+	if n := len(data); n < 2 || data[0] != '"' || data[n-1] != '"' {
+		return errors.Errorf("invalid JSON string of UUID: %q", data)
+	}
 	var err error
-	*this, err = ParseBytes(data)
+	*this, err = ParseBytes(data[1:len(data)-1])
 	return err
 }
 
@@ -804,12 +1112,15 @@ func (this *UUID) UnmarshalJSON(data []byte) error {
 	MarshalJSON implements the json.Marshaler interface.
  */
 
-func (this UUID) MarshalJSON() ([]byte, error) {
+func (this UUID) MarshalJSON() (jsonVal []byte, err error) {
+
+	done := traceStart(TraceMarshal, "MarshalJSON")
+	defer func() { done(err) }()
 
-	jsonVal := make([]byte, 36+2)
+	jsonVal = make([]byte, 36+2)
 	jsonVal[0] = '"'
 	jsonVal[37] = '"'
-	err := this.MarshalTextTo(jsonVal[1:37])
+	err = this.MarshalTextTo(jsonVal[1:37])
 
 	return jsonVal, err
 }
@@ -831,10 +1142,67 @@ func (this UUID) MarshalJSON() ([]byte, error) {
  */
 
 func (this UUID) String() string {
-	dst, _  := this.MarshalText()
+	var buf [36]byte
+	dst, _ := this.AppendText(buf[:0])
 	return string(dst)
 }
 
+/**
+	Converts UUID in to string in upper case
+
+	Required by legacy systems like SAP and some Microsoft tooling
+ */
+
+func (this UUID) StringUpper() string {
+	var dst [36]byte
+	this.MarshalUpperTextTo(dst[:])
+	return string(dst[:])
+}
+
+/**
+	Marshal text in upper case to preallocated slice
+ */
+
+func (this UUID) MarshalUpperTextTo(dst []byte) error {
+	if err := this.MarshalTextTo(dst); err != nil {
+		return err
+	}
+	toUpperHex(dst[:36])
+	return nil
+}
+
+func toUpperHex(dst []byte) {
+	for i, c := range dst {
+		if 'a' <= c && c <= 'f' {
+			dst[i] = c - 'a' + 'A'
+		}
+	}
+}
+
+/**
+	Converts UUID in to braced {GUID} form used by Windows registry and COM interop
+
+	{xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+ */
+
+func (this UUID) StringBraced() string {
+	var dst [38]byte
+	dst[0] = '{'
+	this.MarshalTextTo(dst[1:])
+	dst[37] = '}'
+	return string(dst[:])
+}
+
+/**
+	Parses braced {GUID} form of UUID
+
+	Unlike Parse requires the braces and the canonical form inside them
+ */
+
+func ParseBraced(s string) (UUID, error) {
+	return ParseFormat([]byte(s), BracedFormat)
+}
+
 /**
 	Gets URN name of the UUID
  */
@@ -859,6 +1227,12 @@ func (v Version) String() string {
 		return "RandomlyGeneratedVer4"
 	case NamebasedVer5:
 		return "NamebasedVer5"
+	case ReorderedTimebasedVer6:
+		return "ReorderedTimebasedVer6"
+	case UnixTimebasedVer7:
+		return "UnixTimebasedVer7"
+	case CustomVer8:
+		return "CustomVer8"
 	}
 	return fmt.Sprintf("BadVersion%d", int(v))
 }
@@ -26,6 +26,9 @@ import (
 	"fmt"
 	"time"
 	"math/rand"
+	"strings"
+	"io"
+	"github.com/pkg/errors"
 )
 
 func TestSuit(t *testing.T) {
@@ -43,6 +46,15 @@ func TestSuit(t *testing.T) {
 	assert.True(t, Equal(nil, nil))
 	assert.True(t, Equal(&uuid, &uuid))
 
+	// check EqualConstantTime
+
+	token, _ := NameUUIDFromBytes([]byte("token"), NamebasedVer5)
+	other, _ := NameUUIDFromBytes([]byte("other"), NamebasedVer5)
+	assert.True(t, token.EqualConstantTime(token))
+	assert.False(t, token.EqualConstantTime(other))
+	assert.False(t, token.EqualConstantTime(CreateUUID(token.MostSignificantBits(), token.LeastSignificantBits() ^ 1)))
+	assert.False(t, token.EqualConstantTime(CreateUUID(token.MostSignificantBits() ^ 1, token.LeastSignificantBits())))
+
 	// check Versions
 
 	testTimebasedUUID(t)
@@ -54,6 +66,263 @@ func TestSuit(t *testing.T) {
 
 	testParser(t)
 
+	testArray(t)
+
+	testUpper(t)
+
+	testBraced(t)
+
+	testSpecialValues(t)
+
+	testSetVersionAndVariant(t)
+
+	testAppendText(t)
+
+	testVersionAwareTime(t)
+
+}
+
+func testVersionAwareTime(t *testing.T) {
+
+	now := time.Date(2024, 3, 1, 12, 34, 56, 789123400, time.UTC)
+	later := now.Add(time.Hour + 123456700)
+
+	// version 6 keeps version and the rest of bits
+
+	v6, _ := MinUUIDForTime(now, ReorderedTimebasedVer6)
+	v6.leastSigBits = 0xABCDEF0123456789 & counterMask | variantIETFBits
+	assert.Equal(t, now, v6.Time().UTC())
+
+	v6.SetTime(later)
+	assert.Equal(t, ReorderedTimebasedVer6, v6.Version())
+	assert.Equal(t, later, v6.Time().UTC())
+	assert.Equal(t, 0xABCDEF0123456789 & counterMask | variantIETFBits, v6.leastSigBits)
+	expected, _ := MinUUIDForTime(later, ReorderedTimebasedVer6)
+	assert.Equal(t, expected.mostSigBits, v6.mostSigBits)
+
+	// version 7 keeps version, milliseconds and the fraction with 244 nanoseconds precision
+
+	v7, _ := MinUUIDForTime(now, UnixTimebasedVer7)
+	v7.SetTime(later)
+	assert.Equal(t, UnixTimebasedVer7, v7.Version())
+	assert.Equal(t, IETF, v7.Variant())
+	assert.Equal(t, later.UnixMilli(), v7.UnixTimeMillis())
+	assert.Equal(t, uint64(later.UnixMilli()), v7.mostSigBits >> 16)
+	assert.True(t, later.Sub(v7.Time()) >= 0 && later.Sub(v7.Time()) < 245 * time.Nanosecond)
+	assert.NoError(t, v7.Validate())
+
+	actual := v7
+	actual.SetTime(v7.Time())
+	assert.True(t, v7.Equal(actual))
+
+	v7.SetUnixTimeMillis(-1)
+	assert.Equal(t, UnixTimebasedVer7, v7.Version())
+	assert.Equal(t, int64(0), v7.UnixTimeMillis())
+
+	// other versions are stamped with version 1
+
+	v4 := randomUUIDFromWords(0x0123456789ABCDEF, 0xFEDCBA9876543210)
+	v4.SetTime(now)
+	assert.Equal(t, TimebasedVer1, v4.Version())
+	assert.Equal(t, now.Truncate(100 * time.Nanosecond), v4.Time().UTC())
+
+}
+
+func testAppendText(t *testing.T) {
+
+	uuid, _ := Parse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	dst, err := uuid.AppendText([]byte("id="))
+	assert.NoError(t, err)
+	assert.Equal(t, "id=6ba7b810-9dad-11d1-80b4-00c04fd430c8", string(dst))
+
+	dst, err = uuid.AppendText(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.String(), string(dst))
+
+	// reused buffer
+
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = uuid.AppendText(buf[:0])
+	})
+	assert.Equal(t, float64(0), allocs)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", string(buf))
+
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = uuid.String()
+	})
+	assert.Equal(t, float64(1), allocs)
+
+	var text [36]byte
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = uuid.MarshalTextTo(text[:])
+	})
+	assert.Equal(t, float64(0), allocs)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", string(text[:]))
+
+}
+
+func testSetVersionAndVariant(t *testing.T) {
+
+	uuid := MaxUUID
+
+	for _, version := range []Version{ TimebasedVer1, RandomlyGeneratedVer4, UnixTimebasedVer7, CustomVer8, BadVersion } {
+		uuid.SetVersion(version)
+		assert.Equal(t, version, uuid.Version())
+	}
+	assert.Equal(t, "ffffffff-ffff-0fff-ffff-ffffffffffff", uuid.String())
+
+	for _, variant := range []Variant{ IETF, NCSReserved, MicrosoftReserved, FutureReserved, IETF } {
+		uuid.SetVariant(variant)
+		assert.Equal(t, variant, uuid.Variant())
+	}
+	assert.Equal(t, "ffffffff-ffff-0fff-bfff-ffffffffffff", uuid.String())
+
+	uuid.SetVariant(NCSReserved)
+	assert.Equal(t, "ffffffff-ffff-0fff-3fff-ffffffffffff", uuid.String())
+
+	uuid.SetVariant(UnknownVariant)
+	assert.Equal(t, "ffffffff-ffff-0fff-3fff-ffffffffffff", uuid.String())
+
+	// repair of imported data
+
+	uuid = Empty
+	uuid.SetVersion(RandomlyGeneratedVer4)
+	uuid.SetVariant(IETF)
+	assert.Equal(t, NewUUID(RandomlyGeneratedVer4), uuid)
+
+	// canonicalize
+
+	malformed, _ := Parse("6ba7b810-9dad-01d1-c0b4-00c04fd430c8")
+	assert.Equal(t, MicrosoftReserved, malformed.Variant())
+
+	fixed := malformed.Canonicalize(TimebasedVer1)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", fixed.String())
+	assert.Equal(t, TimebasedVer1, fixed.Version())
+	assert.Equal(t, IETF, fixed.Variant())
+	assert.Equal(t, malformed.Time100Nanos(), fixed.Time100Nanos())
+	assert.Equal(t, malformed.Node(), fixed.Node())
+	assert.Equal(t, fixed, fixed.Canonicalize(TimebasedVer1))
+	assert.Equal(t, "6ba7b810-9dad-01d1-c0b4-00c04fd430c8", malformed.String())
+
+}
+
+func testSpecialValues(t *testing.T) {
+
+	assert.True(t, ZeroUUID.IsZero())
+	assert.True(t, ZeroUUID.IsNil())
+	assert.True(t, Empty.IsZero())
+	assert.False(t, ZeroUUID.IsMax())
+
+	uuid := NewUUID(TimebasedVer1)
+	assert.False(t, uuid.IsZero())
+	assert.False(t, uuid.IsNil())
+	assert.False(t, uuid.IsMax())
+
+	max := CreateUUID(-1, -1)
+	assert.True(t, max.IsMax())
+	assert.False(t, max.IsZero())
+	assert.Equal(t, MaxUUID, max)
+	assert.True(t, MaxUUID.IsMax())
+
+	// parse and format
+
+	assert.Equal(t, "ffffffff-ffff-ffff-ffff-ffffffffffff", MaxUUID.String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", ZeroUUID.String())
+
+	for _, s := range []string{ "ffffffff-ffff-ffff-ffff-ffffffffffff", "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", "urn:uuid:ffffffff-ffff-ffff-ffff-ffffffffffff" } {
+		uuid, err := Parse(s)
+		assert.NoError(t, err)
+		assert.True(t, uuid.IsMax(), s)
+	}
+
+	uuid, err := Parse("00000000-0000-0000-0000-000000000000")
+	assert.NoError(t, err)
+	assert.True(t, uuid.IsZero())
+
+	assert.Equal(t, "{uuid:ffffffff-ffff-ffff-ffff-ffffffffffff special:Max}", fmt.Sprintf("%+v", MaxUUID))
+	assert.Equal(t, "{uuid:00000000-0000-0000-0000-000000000000 special:Nil}", fmt.Sprintf("%+v", ZeroUUID))
+
+	// max is the last in byte orders
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetMaxCounter()
+	uuid.SetTime100Nanos(0x0FFFFFFFFFFFFFFF)
+	assert.Equal(t, -1, uuid.Compare(MaxUUID))
+	assert.Equal(t, -1, CompareSQLServer(uuid, MaxUUID))
+
+}
+
+func testBraced(t *testing.T) {
+
+	uuid, err := Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	if err != nil {
+		t.Fatal("parse failed ", err)
+	}
+
+	assert.Equal(t, "{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}", uuid.StringBraced())
+
+	comp, err := ParseBraced("{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(comp))
+
+	comp, err = ParseBraced("{534B44A1-9BF1-3D20-B71E-CC4EB77C572F}")
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(comp))
+
+	for _, s := range []string {
+		"534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		"(534b44a1-9bf1-3d20-b71e-cc4eb77c572f)",
+		"{534b44a1-9bf1-3d20-b71e-cc4eb77c572f",
+		"{534b44a19bf13d20b71ecc4eb77c572f}",
+		"{534b44a1-9bf1-3d20-b71e-cc4eb77c572f}}",
+	} {
+		_, err = ParseBraced(s)
+		assert.Error(t, err, s)
+	}
+
+}
+
+func testUpper(t *testing.T) {
+
+	uuid, err := Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	if err != nil {
+		t.Fatal("parse failed ", err)
+	}
+
+	assert.Equal(t, "534B44A1-9BF1-3D20-B71E-CC4EB77C572F", uuid.StringUpper())
+
+	comp, err := Parse(uuid.StringUpper())
+	assert.NoError(t, err)
+	assert.True(t, uuid.Equal(comp))
+
+	var dst [36]byte
+	assert.Equal(t, ErrorWrongLen, uuid.MarshalUpperTextTo(dst[:35]))
+
+}
+
+type arrayAlias [16]byte
+
+func testArray(t *testing.T) {
+
+	uuid, err := NameUUIDFromBytes([]byte("alex"), NamebasedVer3)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	data, _ := uuid.MarshalBinary()
+	alias := arrayAlias(uuid.Array())
+	assert.Equal(t, data, alias[:])
+
+	assert.True(t, uuid.Equal(FromArray(alias)))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		alias = uuid.Array()
+		uuid = FromArray(alias)
+	})
+	assert.Equal(t, float64(0), allocs)
+
 }
 
 func testParser(t *testing.T) {
@@ -69,6 +338,44 @@ func testParser(t *testing.T) {
 
 	assert.True(t, uuid.Equal(comp))
 
+	// strict hex
+
+	for s, pos := range map[string]string{
+		"zzzzzzzz-zzzz-zzzz-zzzz-zzzzzzzzzzzz": "'z' at position 0",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cg": "'g' at position 35",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd43 c8}": "' ' at position 34",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-0_c04fd430c8": "'_' at position 34",
+		"6ba7b8109dad11d180b400c04fd430cx": "'x' at position 31",
+	} {
+		_, err = Parse(s)
+		assert.Error(t, err, s)
+		assert.Contains(t, err.Error(), pos, s)
+	}
+
+	// base64 and base32
+
+	comp, err = Parse(uuid.EncodeBase64())
+	assert.NoError(t, err)
+	assert.Equal(t, uuid, comp)
+
+	comp, err = Parse(uuid.EncodeBase32())
+	assert.NoError(t, err)
+	assert.Equal(t, uuid, comp)
+
+	comp, err = Parse(strings.ToLower(uuid.EncodeBase32()))
+	assert.NoError(t, err)
+	assert.Equal(t, uuid, comp)
+
+	_, err = Parse("U0tEoZvxPSC3HsxOt3xX+w")
+	assert.Error(t, err)
+
+	_, err = Parse("8ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	assert.Error(t, err)
+
+	comp, err = Parse("6BA7B810-9DAD-11D1-80B4-00C04FD430C8")
+	assert.NoError(t, err)
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", comp.String())
+
 }
 
 func testTimebasedNamedUUID(t *testing.T) {
@@ -164,6 +471,24 @@ func testTimebasedUUID(t *testing.T) {
 	uuid.SetUnixTimeMillis(-1)
 	assert.Equal(t, int64(-1), uuid.UnixTimeMillis())
 
+	// test Microseconds
+	uuid.SetUnixTimeMicros(1700000000123456)
+	assert.Equal(t, int64(1700000000123456), uuid.UnixTimeMicros())
+	assert.Equal(t, int64(1700000000123), uuid.UnixTimeMillis())
+	assert.Equal(t, time.UnixMicro(1700000000123456), uuid.Time())
+
+	uuid.SetUnixTimeMicros(-1)
+	assert.Equal(t, int64(-1), uuid.UnixTimeMicros())
+
+	// test Nanoseconds, truncated to 100 nanoseconds
+	uuid.SetUnixTimeNanos(1700000000123456789)
+	assert.Equal(t, int64(1700000000123456700), uuid.UnixTimeNanos())
+	assert.Equal(t, int64(1700000000123456), uuid.UnixTimeMicros())
+	assert.Equal(t, time.Unix(0, 1700000000123456700), uuid.Time())
+
+	uuid.SetUnixTimeNanos(-100)
+	assert.Equal(t, int64(-100), uuid.UnixTimeNanos())
+
 	// clear
 	uuid.SetUnixTimeMillis(0)
 	assert.Equal(t, int64(0), uuid.UnixTimeMillis())
@@ -234,6 +559,40 @@ func testRandomlyGeneratedUUID(t *testing.T) {
 	assertMarshalJson(t, uuid)
 	assertMarshalBinary(t, uuid)
 
+	assert.Equal(t, RandomlyGeneratedVer4, MustRandom().Version())
+
+	// failures of the random source
+
+	defer func(reader io.Reader) { randomReader = reader }(randomReader)
+
+	randomReader = &failingReader{ failures: randomReadAttempts - 1, reader: bytes.NewReader(bytes.Repeat([]byte{ 0xFF }, 16)) }
+	uuid, err = RandomUUID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ffffffff-ffff-4fff-bfff-ffffffffffff", uuid.String())
+
+	randomReader = &failingReader{ failures: randomReadAttempts }
+	uuid, err = RandomUUID()
+	assert.True(t, errors.Is(err, errEntropy))
+	assert.Equal(t, Empty, uuid)
+
+	randomReader = &failingReader{ failures: randomReadAttempts }
+	assert.PanicsWithError(t, err.Error(), func() { MustRandom() })
+
+}
+
+var errEntropy = errors.New("no entropy")
+
+type failingReader struct {
+	failures int
+	reader   io.Reader
+}
+
+func (this *failingReader) Read(p []byte) (int, error) {
+	if this.failures > 0 {
+		this.failures--
+		return 0, errEntropy
+	}
+	return this.reader.Read(p)
 }
 
 func testNamebasedUUID(t *testing.T) {
@@ -348,3 +707,39 @@ func assertMarshalSortableBinary(t *testing.T, uuid UUID) {
 
 }
 
+func BenchmarkMarshalTextTo(b *testing.B) {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(time.Now())
+	var dst [36]byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = uuid.MarshalTextTo(dst[:])
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(time.Now())
+	buf := make([]byte, 0, 36)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = uuid.AppendText(buf[:0])
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime(time.Now())
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = uuid.String()
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	src := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseBytes(src)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	gotoml "github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+)
+
+type tomlConfig struct {
+	Instance UUID  `toml:"instance"`
+	Parent   *UUID `toml:"parent"`
+	Peers    []UUID `toml:"peers"`
+}
+
+/**
+	pelletier/go-toml v1 treats slices of structs as array of tables, so only scalar fields are used
+ */
+
+type goTOMLConfig struct {
+	Instance UUID  `toml:"instance"`
+	Parent   *UUID `toml:"parent"`
+}
+
+func TestTOML(t *testing.T) {
+
+	instance := NewUUID(TimebasedVer1)
+	instance.SetUnixTimeMillis(123)
+	instance.SetCounter(555)
+
+	parent, err := NameUUIDFromBytes([]byte("parent"), NamebasedVer5)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	source := "instance = \"" + instance.String() + "\"\n" +
+		"parent = \"" + parent.URN() + "\"\n" +
+		"peers = [\"" + instance.String() + "\", \"" + parent.String() + "\"]\n"
+
+	// BurntSushi/toml
+
+	var actual tomlConfig
+	_, err = toml.Decode(source, &actual)
+	if err != nil {
+		t.Fatal("fail to decode toml ", err)
+	}
+	assertTOMLConfig(t, instance, parent, actual)
+
+	buf := new(bytes.Buffer)
+	err = toml.NewEncoder(buf).Encode(actual)
+	if err != nil {
+		t.Fatal("fail to encode toml ", err)
+	}
+	assert.Contains(t, buf.String(), "instance = \"" + instance.String() + "\"")
+
+	actual = tomlConfig{}
+	_, err = toml.Decode(buf.String(), &actual)
+	if err != nil {
+		t.Fatal("fail to decode toml ", err)
+	}
+	assertTOMLConfig(t, instance, parent, actual)
+
+	_, err = toml.Decode("instance = \"bad\"", &actual)
+	assert.Error(t, err)
+
+	// pelletier/go-toml
+
+	source = "instance = \"" + instance.String() + "\"\n" +
+		"parent = \"" + parent.URN() + "\"\n"
+
+	var config goTOMLConfig
+	err = gotoml.Unmarshal([]byte(source), &config)
+	if err != nil {
+		t.Fatal("fail to unmarshal toml ", err)
+	}
+	assert.True(t, instance.Equal(config.Instance))
+	assert.True(t, Equal(&parent, config.Parent))
+
+	data, err := gotoml.Marshal(config)
+	if err != nil {
+		t.Fatal("fail to marshal toml ", err)
+	}
+	assert.Contains(t, string(data), instance.String())
+
+	config = goTOMLConfig{}
+	err = gotoml.Unmarshal(data, &config)
+	if err != nil {
+		t.Fatal("fail to unmarshal toml ", err)
+	}
+	assert.True(t, instance.Equal(config.Instance))
+	assert.True(t, Equal(&parent, config.Parent))
+
+	err = gotoml.Unmarshal([]byte("instance = \"bad\""), &config)
+	assert.Error(t, err)
+
+}
+
+func assertTOMLConfig(t *testing.T, instance, parent UUID, actual tomlConfig) {
+
+	assert.True(t, instance.Equal(actual.Instance))
+	assert.True(t, Equal(&parent, actual.Parent))
+	if assert.Equal(t, 2, len(actual.Peers)) {
+		assert.True(t, instance.Equal(actual.Peers[0]))
+		assert.True(t, parent.Equal(actual.Peers[1]))
+	}
+
+}
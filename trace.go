@@ -0,0 +1,92 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+/**
+	Operation reported to Tracer
+ */
+
+type TraceOp int
+
+// Constants passed to Tracer.
+const (
+	TraceParse = TraceOp(iota)
+	TraceMarshal
+	TraceGenerate
+)
+
+/**
+	Tracer is called on start of the operation with the operation kind and the function name,
+	the returned function (could be nil) is called on finish with the result error
+
+	Used to attach OpenTelemetry spans or sampling profilers to the hot paths, must be safe for concurrent use
+ */
+
+type Tracer func(op TraceOp, name string) func(err error)
+
+type tracerHolder struct {
+	tracer Tracer
+}
+
+var currentTracer atomic.Value
+
+func init() {
+	currentTracer.Store(tracerHolder{})
+}
+
+/**
+	Installs tracer for the package, nil disables tracing
+ */
+
+func SetTracer(tracer Tracer) {
+	currentTracer.Store(tracerHolder{tracer})
+}
+
+func traceNoop(error) {
+}
+
+func traceStart(op TraceOp, name string) func(error) {
+	if tracer := currentTracer.Load().(tracerHolder).tracer; tracer != nil {
+		if done := tracer(op, name); done != nil {
+			return done
+		}
+	}
+	return traceNoop
+}
+
+/**
+	Gets operation name
+ */
+
+func (op TraceOp) String() string {
+	switch op {
+	case TraceParse:
+		return "TraceParse"
+	case TraceMarshal:
+		return "TraceMarshal"
+	case TraceGenerate:
+		return "TraceGenerate"
+	}
+	return fmt.Sprintf("BadTraceOp%d", int(op))
+}
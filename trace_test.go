@@ -0,0 +1,91 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracer(t *testing.T) {
+
+	var mutex sync.Mutex
+	var started, finished []string
+	var failed int
+
+	SetTracer(func(op TraceOp, name string) func(error) {
+		mutex.Lock()
+		started = append(started, op.String() + ":" + name)
+		mutex.Unlock()
+		return func(err error) {
+			mutex.Lock()
+			finished = append(finished, name)
+			if err != nil {
+				failed++
+			}
+			mutex.Unlock()
+		}
+	})
+	defer SetTracer(nil)
+
+	uuid, err := Parse("534b44a1-9bf1-3d20-b71e-cc4eb77c572f")
+	assert.NoError(t, err)
+
+	_, err = Parse("bad")
+	assert.Error(t, err)
+
+	uuid.MarshalText()
+	uuid.MarshalBinary()
+	uuid.MarshalJSON()
+
+	uuid.MarshalSortableBinary()
+	NewUnixTimebasedGenerator().Next()
+	NewTimebasedGenerator(0, 0).Next()
+
+	assert.Equal(t, []string {
+		"TraceParse:ParseBytes",
+		"TraceParse:ParseBytes",
+		"TraceMarshal:MarshalText",
+		"TraceMarshal:MarshalBinary",
+		"TraceMarshal:MarshalJSON",
+		"TraceMarshal:MarshalSortableBinary",
+		"TraceGenerate:UnixTimebasedGenerator.Next",
+		"TraceGenerate:TimebasedGenerator.Next",
+	}, started)
+	assert.Equal(t, len(started), len(finished))
+	assert.Equal(t, 2, failed)
+
+	// tracer returning nil finish function
+
+	SetTracer(func(op TraceOp, name string) func(error) {
+		return nil
+	})
+	_, err = Parse(uuid.String())
+	assert.NoError(t, err)
+
+	SetTracer(nil)
+	started = nil
+	Parse(uuid.String())
+	assert.Nil(t, started)
+
+	assert.Equal(t, "BadTraceOp10", TraceOp(10).String())
+
+}
@@ -0,0 +1,103 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+)
+
+/**
+	W3C Trace Context header: version-traceid-parentid-traceflags, e.g. 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+ */
+
+const (
+	traceparentLen = 55
+	TraceFlagSampled = byte(0x01)
+)
+
+var (
+	ErrorInvalidTraceparent = errors.New("invalid traceparent")
+)
+
+/**
+	Extracts 128-bit trace-id from the traceparent header value
+
+	Header of future versions could have additional fields after the trace flags, they are ignored
+ */
+
+func ParseTraceparent(header string) (UUID, error) {
+
+	if len(header) < traceparentLen || header[2] != '-' || header[35] != '-' || header[52] != '-' {
+		return Empty, ErrorInvalidTraceparent
+	}
+
+	version := header[:2]
+	switch {
+	case !isLowerHex(version) || version == "ff":
+		return Empty, errors.Wrapf(ErrorInvalidTraceparent, "version '%s'", version)
+	case version == "00" && len(header) != traceparentLen:
+		return Empty, errors.Wrapf(ErrorInvalidTraceparent, "len %d", len(header))
+	case len(header) > traceparentLen && header[traceparentLen] != '-':
+		return Empty, ErrorInvalidTraceparent
+	}
+
+	traceID, parentID, flags := header[3:35], header[36:52], header[53:55]
+	if !isLowerHex(traceID) || !isLowerHex(parentID) || !isLowerHex(flags) {
+		return Empty, errors.Wrapf(ErrorInvalidTraceparent, "non lower hex in '%s'", header)
+	}
+	if traceID == "00000000000000000000000000000000" {
+		return Empty, errors.Wrap(ErrorInvalidTraceparent, "all zero trace-id")
+	}
+	if parentID == "0000000000000000" {
+		return Empty, errors.Wrap(ErrorInvalidTraceparent, "all zero parent-id")
+	}
+
+	var data [16]byte
+	decodeHex(data[:], []byte(traceID))
+	return FromArray(data), nil
+}
+
+/**
+	Builds version 00 of the traceparent header value with UUID as the trace-id
+ */
+
+func (this UUID) Traceparent(parentID [8]byte, flags byte) string {
+
+	var buf [traceparentLen]byte
+	var data [16]byte
+	this.MarshalBinaryTo(data[:])
+
+	copy(buf[:], "00-")
+	encodeHex(buf[3:35], data[:])
+	buf[35] = '-'
+	encodeHex(buf[36:52], parentID[:])
+	buf[52] = '-'
+	putHex(buf[53:], flags)
+	return string(buf[:])
+}
+
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"math/bits"
+	"time"
+)
+
+/**
+	ULID: 48-bit unix time in milliseconds + 80 bits of randomness in the big-endian order
+ */
+
+type ULID [16]byte
+
+const (
+	ulidSubMillisBits = 18
+)
+
+var (
+	ErrorULIDTimeOverflow = errors.New("time is out of ULID range")
+)
+
+/**
+	Parses 26 characters of Crockford Base32 representation of ULID
+ */
+
+func ParseULID(s string) (ULID, error) {
+	uuid, err := DecodeBase32(s)
+	if err != nil {
+		return ULID{}, err
+	}
+	return ULID(uuid.Array()), nil
+}
+
+/**
+	Gets 26 characters of Crockford Base32 representation of ULID
+ */
+
+func (u ULID) String() string {
+	return FromArray(u).EncodeBase32()
+}
+
+/**
+	Gets time of ULID in milliseconds precision
+ */
+
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(u.UnixTimeMillis())
+}
+
+/**
+	Gets unix time of ULID in milliseconds
+ */
+
+func (u ULID) UnixTimeMillis() int64 {
+	return int64(binary.BigEndian.Uint64(u[:8]) >> 16)
+}
+
+/**
+	Converts ULID to the Time-based UUID (version 1)
+
+	Milliseconds are stored in the timestamp, 80 bits of randomness are scaled to the 10000 sub-millisecond
+	100-nanosecond ticks of the timestamp times 2^62 values of the counter, so the conversion keeps ULID ordering
+	in MarshalSortableBinary; precision of randomness is reduced by 2^80 / (10000 * 2^62), about 26 ULIDs of the same
+	millisecond map to the same UUID
+
+	ULID time after the year 5236 does not fit in to the 60-bit timestamp
+ */
+
+func FromULID(u ULID) (UUID, error) {
+
+	millis := int64(binary.BigEndian.Uint64(u[:8]) >> 16)
+
+	// 128-bit randomness * 10000 / 2^18 = subMillis * 2^62 + counter
+	hi, lo := bits.Mul64(binary.BigEndian.Uint64(u[8:]), uint64(one100NanosInMillis))
+	hi += uint64(binary.BigEndian.Uint16(u[6:8])) * uint64(one100NanosInMillis)
+	subMillis := int64(hi >> 16)
+	counter := (hi << 46 | lo >> ulidSubMillisBits) & counterMask
+
+	time100Nanos := millis * one100NanosInMillis + subMillis + num100NanosSinceUUIDEpoch
+	if uint64(time100Nanos) > maxTime100Nanos {
+		return Empty, ErrorULIDTimeOverflow
+	}
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetTime100Nanos(time100Nanos)
+	uuid.SetCounterUnsigned(counter)
+	return uuid, nil
+}
+
+/**
+	Converts Time-based UUID (version 1) to ULID
+
+	Conversion is lossless for UUIDs since unix epoch: FromULID(uuid.ToULID()) == uuid,
+	ULID could not hold time before unix epoch
+ */
+
+func (this UUID) ToULID() (ULID, error) {
+
+	var u ULID
+
+	if this.Version() != TimebasedVer1 {
+		return u, ErrorRequiredTimebasedUUID
+	}
+
+	unixTime100Nanos := this.UnixTime100Nanos()
+	if unixTime100Nanos < 0 {
+		return u, ErrorULIDTimeOverflow
+	}
+	millis := unixTime100Nanos / one100NanosInMillis
+
+	// inverse of the scaling in FromULID rounded up: (subMillis * 2^62 + counter) * 2^18 / 10000
+	subMillis := uint64(unixTime100Nanos % one100NanosInMillis)
+	hi, lo := subMillis << 16 | this.CounterUnsigned() >> 46, this.CounterUnsigned() << ulidSubMillisBits
+	lo, carry := bits.Add64(lo, uint64(one100NanosInMillis) - 1, 0)
+	hi += carry
+	randomHigh, rem := hi / uint64(one100NanosInMillis), hi % uint64(one100NanosInMillis)
+	randomLow, _ := bits.Div64(rem, lo, uint64(one100NanosInMillis))
+
+	binary.BigEndian.PutUint64(u[:8], uint64(millis) << 16 | randomHigh)
+	binary.BigEndian.PutUint64(u[8:], randomLow)
+	return u, nil
+}
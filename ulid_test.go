@@ -0,0 +1,127 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestULID(t *testing.T) {
+
+	// spec example
+
+	u, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	assert.NoError(t, err)
+	assert.Equal(t, "01ARZ3NDEKTSV4RRFFQ69G5FAV", u.String())
+	assert.Equal(t, int64(1469922850259), u.UnixTimeMillis())
+	assert.Equal(t, int64(1469922850259), u.Time().UnixNano() / int64(time.Millisecond))
+
+	uuid, err := FromULID(u)
+	assert.NoError(t, err)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, int64(1469922850259), uuid.UnixTimeMillis())
+
+	_, err = ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FA")
+	assert.Error(t, err)
+
+	// UUID -> ULID -> UUID is lossless
+
+	for i := 0; i != 1000; i = i + 1 {
+		uuid := NewUUID(TimebasedVer1)
+		uuid.SetUnixTime100Nanos(rand.Int63n(int64(maxTime100Nanos) - num100NanosSinceUUIDEpoch))
+		uuid.SetCounter(rand.Int63())
+
+		u, err := uuid.ToULID()
+		assert.NoError(t, err)
+		assert.Equal(t, uuid.UnixTimeMillis(), u.UnixTimeMillis())
+
+		actual, err := FromULID(u)
+		assert.NoError(t, err)
+		assert.True(t, uuid.Equal(actual))
+	}
+
+	// ULID ordering is kept in sortable binary
+
+	ulids := make([]ULID, 1000)
+	for i := range ulids {
+		rand.Read(ulids[i][:])
+		ulids[i][0] = byte(rand.Intn(4))
+	}
+	sort.Slice(ulids, func(i, j int) bool {
+		return bytes.Compare(ulids[i][:], ulids[j][:]) < 0
+	})
+	for i := 1; i < len(ulids); i++ {
+		prev, _ := FromULID(ulids[i-1])
+		next, _ := FromULID(ulids[i])
+		prevData, _ := prev.MarshalSortableBinary()
+		nextData, _ := next.MarshalSortableBinary()
+		assert.True(t, bytes.Compare(prevData, nextData) <= 0)
+	}
+
+	// errors
+
+	_, err = NewUUID(RandomlyGeneratedVer4).ToULID()
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(-1)
+	_, err = uuid.ToULID()
+	assert.Equal(t, ErrorULIDTimeOverflow, err)
+
+	_, err = FromULID(ULID{ 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF })
+	assert.Equal(t, ErrorULIDTimeOverflow, err)
+
+}
+
+func TestULIDOrderWithinMillisecond(t *testing.T) {
+
+	for i := 0; i != 10000; i = i + 1 {
+
+		var first, second ULID
+		rand.Read(first[:])
+		first[0] = 0
+		copy(second[:6], first[:6])
+		rand.Read(second[8:])
+		// adjacent high 18 bits of randomness scaled to the same sub-millisecond tick
+		randomHigh := uint32(binary.BigEndian.Uint16(first[6:8])) << 2 | uint32(first[8] >> 6) + uint32(i % 2)
+		binary.BigEndian.PutUint16(second[6:8], uint16(randomHigh >> 2))
+		second[8] = second[8] & 0x3F | byte(randomHigh << 6)
+		if bytes.Compare(first[:], second[:]) > 0 {
+			first, second = second, first
+		}
+
+		firstUUID, err := FromULID(first)
+		assert.NoError(t, err)
+		secondUUID, err := FromULID(second)
+		assert.NoError(t, err)
+
+		firstData, _ := firstUUID.MarshalSortableBinary()
+		secondData, _ := secondUUID.MarshalSortableBinary()
+		assert.True(t, bytes.Compare(firstData, secondData) <= 0, "%s %s", first, second)
+	}
+
+}
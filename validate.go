@@ -0,0 +1,77 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+	Clock skew allowed for timestamps in the future
+ */
+
+const validateMaxClockSkew = 24 * time.Hour
+
+var (
+	ErrorInvalidVariant = errors.New("invalid variant")
+	ErrorUnknownVersion = errors.New("unknown version")
+)
+
+/**
+	Checks structure of the UUID against the current time
+
+	Variant must be IETF, version must be in range [1, 8], timestamp of time-based versions 1, 6 and 7
+	must be not later than one day after the current time, timestamp of version 7 must be since unix epoch
+
+	Errors are ErrorInvalidVariant, ErrorUnknownVersion and ErrorTimeOutOfRange wrapped with details,
+	so Nil and Max UUIDs are not valid
+ */
+
+func (this UUID) Validate() error {
+	return this.ValidateAt(time.Now())
+}
+
+/**
+	Checks structure of the UUID like Validate against the reference time instead of the current time
+
+	Used to validate UUIDs of generators with the custom clock, like ValidateAt(clock())
+ */
+
+func (this UUID) ValidateAt(now time.Time) error {
+
+	if variant := this.Variant(); variant != IETF {
+		return errors.Wrapf(ErrorInvalidVariant, "%s in '%s'", variant.String(), this.String())
+	}
+
+	version := this.Version()
+	if version == BadVersion || version == UnknownVersion {
+		return errors.Wrapf(ErrorUnknownVersion, "%d in '%s'", (this.mostSigBits & versionMask) >> 12, this.String())
+	}
+
+	if unixTime100Nanos, ok := this.embeddedUnixTime100Nanos(); ok {
+		max := now.Add(validateMaxClockSkew)
+		// versions 1 and 6 are valid since the Gregorian epoch of 1582
+		if version == UnixTimebasedVer7 && unixTime100Nanos < 0 || unixTime100Nanos > max.Unix() * one100NanosInSecond {
+			return errors.Wrapf(ErrorTimeOutOfRange, "%s in '%s'", time.Unix(unixTime100Nanos / one100NanosInSecond, unixTime100Nanos % one100NanosInSecond * 100).UTC().Format(time.RFC3339), this.String())
+		}
+	}
+
+	return nil
+}
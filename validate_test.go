@@ -0,0 +1,104 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+
+	now := time.Now()
+
+	v1 := NewUUID(TimebasedVer1)
+	v1.SetTime(now)
+	assert.NoError(t, v1.Validate())
+
+	v4, err := RandomUUID()
+	assert.NoError(t, err)
+	assert.NoError(t, v4.Validate())
+
+	v7, err := NewUnixTimebasedGenerator().Next()
+	assert.NoError(t, err)
+	assert.NoError(t, v7.Validate())
+
+	v6, _ := MinUUIDForTime(now, ReorderedTimebasedVer6)
+	assert.NoError(t, v6.Validate())
+
+	// variant
+
+	for _, uuid := range []UUID{ ZeroUUID, MaxUUID, v4.Canonicalize(RandomlyGeneratedVer4) } {
+		uuid.SetVariant(MicrosoftReserved)
+		err = uuid.Validate()
+		assert.True(t, errors.Is(err, ErrorInvalidVariant), uuid.String())
+	}
+	assert.True(t, errors.Is(ZeroUUID.Validate(), ErrorInvalidVariant))
+	assert.True(t, errors.Is(MaxUUID.Validate(), ErrorInvalidVariant))
+
+	// version
+
+	for _, version := range []Version{ BadVersion, Version(9), Version(15) } {
+		uuid := v4
+		uuid.SetVersion(version)
+		err = uuid.Validate()
+		assert.True(t, errors.Is(err, ErrorUnknownVersion), uuid.String())
+	}
+
+	// time
+
+	past := NewUUID(TimebasedVer1)
+	past.SetTime(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, past.Validate())
+
+	gregorian := NewUUID(ReorderedTimebasedVer6)
+	gregorian.SetTime100NanosUnsigned(0)
+	assert.NoError(t, gregorian.Validate())
+
+	future := v1.AddDuration(48 * time.Hour)
+	err = future.Validate()
+	assert.True(t, errors.Is(err, ErrorTimeOutOfRange))
+	assert.Contains(t, err.Error(), future.Time().UTC().Format(time.RFC3339))
+	assert.NoError(t, v1.AddDuration(time.Hour).Validate())
+
+	future, _ = MinUUIDForTime(now.Add(48 * time.Hour), UnixTimebasedVer7)
+	assert.True(t, errors.Is(future.Validate(), ErrorTimeOutOfRange))
+
+	far := NewUUID(TimebasedVer1)
+	far.SetTime100Nanos(0x0FFFFFFFFFFFFFFF)
+	assert.True(t, errors.Is(far.Validate(), ErrorTimeOutOfRange))
+
+	// reference time of the custom clock
+
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	gen, err := NewGenerator(WithClock(func() time.Time { return at }))
+	assert.NoError(t, err)
+	uuid, err := gen.Next()
+	assert.NoError(t, err)
+	assert.NoError(t, uuid.ValidateAt(at))
+	assert.True(t, errors.Is(uuid.ValidateAt(at.Add(-48 * time.Hour)), ErrorTimeOutOfRange))
+
+	v7, _ = MinUUIDForTime(at, UnixTimebasedVer7)
+	assert.NoError(t, v7.ValidateAt(at))
+	assert.True(t, errors.Is(v7.ValidateAt(time.Unix(0, 0)), ErrorTimeOutOfRange))
+
+}
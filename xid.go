@@ -0,0 +1,198 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"time"
+)
+
+/**
+	XID: 32-bit unix time in seconds + 3 bytes of machine id + 2 bytes of pid + 3 bytes of counter in the big-endian order
+ */
+
+type XID [12]byte
+
+const (
+	xidStringLen = 20
+	xidPayloadHighBits = 23
+	xidPayloadLowMask = uint64(1) << (64 - xidPayloadHighBits) - 1
+	xidUnixPayloadLowMask = uint64(1) << 52 - 1
+)
+
+var (
+	xidEncoding = base32.NewEncoding("0123456789abcdefghijklmnopqrstuv").WithPadding(base32.NoPadding)
+
+	ErrorXIDTimeOverflow = errors.New("time is out of xid range")
+)
+
+/**
+	Parses 20 characters of lower case Base32hex representation of XID
+ */
+
+func ParseXID(s string) (XID, error) {
+
+	var x XID
+
+	if len(s) != xidStringLen {
+		return x, ErrorWrongLen
+	}
+
+	if _, err := xidEncoding.Decode(x[:], []byte(s)); err != nil {
+		return x, errors.Wrapf(err, "invalid xid '%s'", s)
+	}
+
+	// last character carries 4 bits, the unused bit must be zero
+	if x.String() != s {
+		return x, errors.Errorf("invalid xid '%s'", s)
+	}
+	return x, nil
+}
+
+/**
+	Gets 20 characters of lower case Base32hex representation of XID
+ */
+
+func (x XID) String() string {
+	return xidEncoding.EncodeToString(x[:])
+}
+
+/**
+	Gets unix time of XID in seconds
+ */
+
+func (x XID) UnixTime() int64 {
+	return int64(binary.BigEndian.Uint32(x[:4]))
+}
+
+/**
+	Gets time of XID in seconds precision
+ */
+
+func (x XID) Time() time.Time {
+	return time.Unix(x.UnixTime(), 0)
+}
+
+/**
+	Gets 3 bytes of machine id
+ */
+
+func (x XID) Machine() []byte {
+	return x[4:7]
+}
+
+/**
+	Gets process id
+ */
+
+func (x XID) Pid() uint16 {
+	return binary.BigEndian.Uint16(x[7:9])
+}
+
+/**
+	Gets 24-bit counter
+ */
+
+func (x XID) Counter() int32 {
+	return int32(uint32(x[9]) << 16 | uint32(x[10]) << 8 | uint32(x[11]))
+}
+
+/**
+	Converts XID to the Time-based UUID (version 1)
+
+	Seconds are stored in the timestamp, top 23 bits of machine id, pid and counter are scaled to the
+	sub-second 100-nanosecond ticks of the timestamp, low 41 bits are stored in the counter,
+	so the conversion keeps XID ordering in MarshalSortableBinary and ToXID restores the XID
+ */
+
+func FromXID(x XID) UUID {
+
+	payload := binary.BigEndian.Uint64(x[4:])
+	payloadHigh := payload >> (64 - xidPayloadHighBits)
+	subSecond := int64(payloadHigh * uint64(one100NanosInSecond) >> xidPayloadHighBits)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTime100Nanos(x.UnixTime() * one100NanosInSecond + subSecond)
+	uuid.SetCounterUnsigned(payload & xidPayloadLowMask)
+	return uuid
+}
+
+/**
+	Converts XID to the Unix Time-based UUID (version 7)
+
+	Seconds are stored in the unix_ts_ms, top 12 bits of machine id, pid and counter are stored in rand_a,
+	low 52 bits are stored in rand_b, so the conversion keeps XID ordering and ToXID restores the XID
+ */
+
+func FromXIDUnixTimebased(x XID) UUID {
+
+	payload := binary.BigEndian.Uint64(x[4:])
+
+	var uuid UUID
+	uuid.mostSigBits = uint64(x.UnixTime() * 1000) << 16 | unixTimebasedVersionBits | payload >> 52
+	uuid.leastSigBits = payload & xidUnixPayloadLowMask | variantIETFBits
+	return uuid
+}
+
+/**
+	Converts Time-based UUID (version 1) or Unix Time-based UUID (version 7) to XID
+
+	Conversion is lossy for UUIDs not made by FromXID or FromXIDUnixTimebased:
+	time is truncated to seconds and the bits that do not fit in to 8 bytes of machine id, pid and counter are dropped
+ */
+
+func (this UUID) ToXID() (XID, error) {
+
+	var x XID
+	var seconds int64
+	var payload uint64
+
+	switch this.Version() {
+
+	case TimebasedVer1:
+		unixTime100Nanos := this.UnixTime100Nanos()
+		if unixTime100Nanos < 0 {
+			return x, ErrorXIDTimeOverflow
+		}
+		seconds = unixTime100Nanos / one100NanosInSecond
+
+		// inverse of the scaling in FromXID rounded up, clamped at the end of the second that no XID maps to
+		subSecond := uint64(unixTime100Nanos % one100NanosInSecond)
+		payloadHigh := (subSecond << xidPayloadHighBits + uint64(one100NanosInSecond) - 1) / uint64(one100NanosInSecond)
+		payloadHigh = min(payloadHigh, uint64(1) << xidPayloadHighBits - 1)
+		payload = payloadHigh << (64 - xidPayloadHighBits) | this.CounterUnsigned() & xidPayloadLowMask
+
+	case UnixTimebasedVer7:
+		seconds = int64(this.mostSigBits >> 16) / 1000
+		payload = (this.mostSigBits & subMillisFractionBits) << 52 | this.leastSigBits & xidUnixPayloadLowMask
+
+	default:
+		return x, ErrorRequiredTimebasedUUID
+	}
+
+	if seconds > 0xFFFFFFFF {
+		return x, ErrorXIDTimeOverflow
+	}
+
+	binary.BigEndian.PutUint32(x[:4], uint32(seconds))
+	binary.BigEndian.PutUint64(x[4:], payload)
+	return x, nil
+}
@@ -0,0 +1,144 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXID(t *testing.T) {
+
+	// rs/xid example
+
+	x, err := ParseXID("9m4e2mr0ui3e8a215n4g")
+	assert.NoError(t, err)
+	assert.Equal(t, XID{ 0x4d, 0x88, 0xe1, 0x5b, 0x60, 0xf4, 0x86, 0xe4, 0x28, 0x41, 0x2d, 0xc9 }, x)
+	assert.Equal(t, "9m4e2mr0ui3e8a215n4g", x.String())
+	assert.Equal(t, int64(1300816219), x.UnixTime())
+	assert.Equal(t, []byte{ 0x60, 0xf4, 0x86 }, x.Machine())
+	assert.Equal(t, uint16(0xe428), x.Pid())
+	assert.Equal(t, int32(4271561), x.Counter())
+
+	uuid := FromXID(x)
+	assert.Equal(t, TimebasedVer1, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+	assert.Equal(t, x.UnixTime(), uuid.Time().Unix())
+
+	actual, err := uuid.ToXID()
+	assert.NoError(t, err)
+	assert.Equal(t, x, actual)
+
+	uuid = FromXIDUnixTimebased(x)
+	assert.Equal(t, UnixTimebasedVer7, uuid.Version())
+	assert.Equal(t, IETF, uuid.Variant())
+
+	actual, err = uuid.ToXID()
+	assert.NoError(t, err)
+	assert.Equal(t, x, actual)
+
+	// XID ordering is kept
+
+	xids := make([]XID, 1000)
+	for i := range xids {
+		rand.Read(xids[i][:])
+	}
+	sort.Slice(xids, func(i, j int) bool {
+		return bytes.Compare(xids[i][:], xids[j][:]) < 0
+	})
+	for i := 1; i < len(xids); i++ {
+		prev, _ := FromXID(xids[i-1]).MarshalSortableBinary()
+		next, _ := FromXID(xids[i]).MarshalSortableBinary()
+		assert.True(t, bytes.Compare(prev, next) <= 0)
+
+		prev, _ = FromXIDUnixTimebased(xids[i-1]).MarshalBinary()
+		next, _ = FromXIDUnixTimebased(xids[i]).MarshalBinary()
+		assert.True(t, bytes.Compare(prev, next) <= 0)
+
+		actual, err = FromXID(xids[i]).ToXID()
+		assert.NoError(t, err)
+		assert.Equal(t, xids[i], actual)
+	}
+
+	// lossy conversion of time
+
+	uuid = NewUUID(TimebasedVer1)
+	uuid.SetUnixTimeMillis(1300816219999)
+	actual, err = uuid.ToXID()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1300816219), actual.UnixTime())
+
+	// errors
+
+	_, err = ParseXID("9m4e2mr0ui3e8a215n4")
+	assert.Equal(t, ErrorWrongLen, err)
+
+	_, err = ParseXID("9m4e2mr0ui3e8a215n4G")
+	assert.Error(t, err)
+
+	_, err = ParseXID("9m4e2mr0ui3e8a215n4h")
+	assert.Error(t, err)
+
+	_, err = NewUUID(RandomlyGeneratedVer4).ToXID()
+	assert.Equal(t, ErrorRequiredTimebasedUUID, err)
+
+	uuid.SetUnixTimeMillis(-1000)
+	_, err = uuid.ToXID()
+	assert.Equal(t, ErrorXIDTimeOverflow, err)
+
+}
+
+func TestXIDEndOfSecond(t *testing.T) {
+
+	seconds := int64(1700000000)
+
+	uuid := NewUUID(TimebasedVer1)
+	uuid.SetUnixTime100Nanos(seconds * one100NanosInSecond + one100NanosInSecond - 1)
+	uuid.SetCounter(rand.Int63())
+
+	last, err := uuid.ToXID()
+	assert.NoError(t, err)
+	assert.Equal(t, seconds, last.UnixTime())
+
+	// round trip keeps the second and the XID
+
+	actual := FromXID(last)
+	assert.Equal(t, seconds, actual.UnixTime100Nanos() / one100NanosInSecond)
+	again, err := actual.ToXID()
+	assert.NoError(t, err)
+	assert.Equal(t, last, again)
+
+	// ordering is kept against the previous tick and the next second
+
+	uuid.SetUnixTime100Nanos(seconds * one100NanosInSecond + one100NanosInSecond - 2)
+	prev, err := uuid.ToXID()
+	assert.NoError(t, err)
+	assert.True(t, bytes.Compare(prev[:], last[:]) <= 0)
+
+	uuid.SetUnixTime100Nanos((seconds + 1) * one100NanosInSecond)
+	uuid.SetMinCounter()
+	next, err := uuid.ToXID()
+	assert.NoError(t, err)
+	assert.True(t, bytes.Compare(last[:], next[:]) < 0)
+
+}
@@ -0,0 +1,76 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+/**
+	MarshalXML implements the xml.Marshaler interface.
+
+	UUID is stored as the canonical string in the element content
+ */
+
+func (this UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(this.String(), start)
+}
+
+/**
+	UnmarshalXML implements the xml.Unmarshaler interface.
+
+	Empty element content leaves the UUID unchanged, like null in JSON.
+ */
+
+func (this *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var content string
+	if err := d.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+	return this.unmarshalXMLString(content)
+}
+
+/**
+	MarshalXMLAttr implements the xml.MarshalerAttr interface.
+ */
+
+func (this UUID) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: this.String()}, nil
+}
+
+/**
+	UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface.
+
+	Empty attribute value leaves the UUID unchanged.
+ */
+
+func (this *UUID) UnmarshalXMLAttr(attr xml.Attr) error {
+	return this.unmarshalXMLString(attr.Value)
+}
+
+func (this *UUID) unmarshalXMLString(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	var err error
+	*this, err = Parse(s)
+	return err
+}
@@ -0,0 +1,73 @@
+/*
+ *
+ * Copyright 2020-present Arpabet Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package timeuuid
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type xmlEnvelope struct {
+	XMLName xml.Name `xml:"envelope"`
+	ID      UUID     `xml:"id,attr"`
+	Body    UUID     `xml:"body"`
+	Missing UUID     `xml:"missing"`
+}
+
+func TestXML(t *testing.T) {
+
+	id, err := NameUUIDFromBytes([]byte("attr"), NamebasedVer5)
+	if err != nil {
+		t.Fatal("fail to create name uuid ", err)
+	}
+
+	body := NewUUID(TimebasedVer1)
+	body.SetUnixTimeMillis(123)
+	body.SetCounter(555)
+
+	data, err := xml.Marshal(xmlEnvelope{ID: id, Body: body})
+	if err != nil {
+		t.Fatal("fail to MarshalXML ", err)
+	}
+
+	expected := `<envelope id="` + id.String() + `"><body>` + body.String() + `</body><missing>` + Empty.String() + `</missing></envelope>`
+	assert.Equal(t, expected, string(data))
+
+	var actual xmlEnvelope
+	err = xml.Unmarshal(data, &actual)
+	if err != nil {
+		t.Fatal("fail to UnmarshalXML ", err)
+	}
+
+	assert.True(t, id.Equal(actual.ID))
+	assert.True(t, body.Equal(actual.Body))
+
+	// whitespace around content and empty elements
+
+	err = xml.Unmarshal([]byte("<envelope><body>\n  "+body.String()+"\n</body><missing/></envelope>"), &actual)
+	assert.NoError(t, err)
+	assert.True(t, body.Equal(actual.Body))
+	assert.True(t, Empty.Equal(actual.Missing))
+
+	err = xml.Unmarshal([]byte(`<envelope id="bad"></envelope>`), &actual)
+	assert.Error(t, err)
+
+}